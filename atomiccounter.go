@@ -0,0 +1,148 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+// #include "gl.h"
+import "C"
+import (
+	"encoding/binary"
+	"unsafe"
+)
+
+// Atomic Counter Buffers
+
+// void glGetActiveAtomicCounterBufferiv(GLuint program, GLuint bufferIndex, GLenum pname, GLint *params);
+//
+// For single valued parameters, see GetActiveAtomicCounterBufferCounterIndices
+// for ATOMIC_COUNTER_BUFFER_ACTIVE_ATOMIC_COUNTER_INDICES.
+func (program Program) GetActiveAtomicCounterBufferiv(bufferIndex uint, pname GLenum) int {
+	var rv C.GLint
+
+	C.glGetActiveAtomicCounterBufferiv(C.GLuint(program), C.GLuint(bufferIndex), C.GLenum(pname), &rv)
+	return int(rv)
+}
+
+// Returns the uniform indices of the active atomic counters in the buffer.
+func (program Program) GetActiveAtomicCounterBufferCounterIndices(bufferIndex uint) []uint32 {
+	n := program.GetActiveAtomicCounterBufferiv(bufferIndex, ATOMIC_COUNTER_BUFFER_ACTIVE_ATOMIC_COUNTERS)
+	if n == 0 {
+		return nil
+	}
+	indices := make([]uint32, n)
+	C.glGetActiveAtomicCounterBufferiv(C.GLuint(program), C.GLuint(bufferIndex),
+		C.GLenum(ATOMIC_COUNTER_BUFFER_ACTIVE_ATOMIC_COUNTER_INDICES), (*C.GLint)(unsafe.Pointer(&indices[0])))
+	return indices
+}
+
+// An atomic_uint uniform, or array of them, of a program
+type AtomicCounter struct {
+	Name    string
+	Binding uint // ATOMIC_COUNTER_BUFFER binding point
+	Offset  int  // in bytes, within the buffer at Binding
+	Size    int  // number of array elements, 1 for non-arrays
+}
+
+// AtomicCounters backs the atomic counters of a program with buffers of its
+// own, one per binding point the program uses.
+type AtomicCounters struct {
+	Counters []AtomicCounter
+
+	bindings []uint
+	sizes    []int
+	buffers  []Buffer
+}
+
+// Discovers the active atomic counters of program and creates zeroed buffers
+// for them. Call Bind before drawing or dispatching with the program.
+func NewAtomicCounters(program Program) *AtomicCounters {
+	n := program.Get(ACTIVE_ATOMIC_COUNTER_BUFFERS)
+	counters := &AtomicCounters{
+		bindings: make([]uint, n),
+		sizes:    make([]int, n),
+		buffers:  make([]Buffer, n),
+	}
+	CreateBuffers(counters.buffers)
+
+	for b := range counters.buffers {
+		binding := uint(program.GetActiveAtomicCounterBufferiv(uint(b), ATOMIC_COUNTER_BUFFER_BINDING))
+		counters.bindings[b] = binding
+		counters.sizes[b] = program.GetActiveAtomicCounterBufferiv(uint(b), ATOMIC_COUNTER_BUFFER_DATA_SIZE)
+
+		indices := program.GetActiveAtomicCounterBufferCounterIndices(uint(b))
+		offsets := program.GetActiveUniformsiv(indices, UNIFORM_OFFSET)
+		sizes := program.GetActiveUniformsiv(indices, UNIFORM_SIZE)
+		for i, index := range indices {
+			counters.Counters = append(counters.Counters, AtomicCounter{
+				Name:    program.GetActiveUniformName(uint(index)),
+				Binding: binding,
+				Offset:  int(offsets[i]),
+				Size:    int(sizes[i]),
+			})
+		}
+
+		counters.buffers[b].Data(counters.sizes[b], make([]byte, counters.sizes[b]), DYNAMIC_COPY)
+	}
+	return counters
+}
+
+// Binds the buffers to their ATOMIC_COUNTER_BUFFER binding points. Like
+// every BindBufferBase, this also binds the last buffer to the generic
+// ATOMIC_COUNTER_BUFFER target. The other methods leave bindings alone.
+func (counters *AtomicCounters) Bind() {
+	for b, buffer := range counters.buffers {
+		buffer.BindBufferBase(ATOMIC_COUNTER_BUFFER, counters.bindings[b])
+	}
+}
+
+// Sets all counters to zero
+func (counters *AtomicCounters) Reset() {
+	for b, buffer := range counters.buffers {
+		buffer.SubData(0, counters.sizes[b], make([]byte, counters.sizes[b]))
+	}
+}
+
+// Returns the values of all counters, in the order of Counters, with Size
+// values for each array. Shader writes issued before are waited for.
+func (counters *AtomicCounters) Read() []uint32 {
+	if len(counters.buffers) == 0 {
+		return nil
+	}
+	MemoryBarrier(BUFFER_UPDATE_BARRIER_BIT)
+
+	data := make([][]byte, len(counters.buffers))
+	for b, buffer := range counters.buffers {
+		data[b] = make([]byte, counters.sizes[b])
+		buffer.GetSubData(0, counters.sizes[b], data[b])
+	}
+
+	var values []uint32
+	for _, counter := range counters.Counters {
+		b := counters.buffer(counter.Binding)
+		for i := 0; i < counter.Size; i++ {
+			// Atomic counter arrays are tightly packed
+			values = append(values, binary.LittleEndian.Uint32(data[b][counter.Offset+4*i:]))
+		}
+	}
+	return values
+}
+
+// Returns the index of the buffer for binding point
+func (counters *AtomicCounters) buffer(binding uint) int {
+	for b := range counters.bindings {
+		if counters.bindings[b] == binding {
+			return b
+		}
+	}
+	return -1
+}
+
+// Deletes the buffers
+func (counters *AtomicCounters) Delete() {
+	DeleteBuffers(counters.buffers)
+	counters.Counters = nil
+	counters.bindings = nil
+	counters.sizes = nil
+	counters.buffers = nil
+}
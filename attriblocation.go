@@ -6,6 +6,7 @@ package gl
 
 // #include "gl.h"
 import "C"
+import "unsafe"
 
 // AttribLocation
 
@@ -43,11 +44,123 @@ func (indx AttribLocation) Attrib4fv(values *[4]float32) {
 	C.glVertexAttrib4fv(C.GLuint(indx), (*C.GLfloat)(&values[0]))
 }
 
+func (indx AttribLocation) Attrib4Nbv(values *[4]int8) {
+	C.glVertexAttrib4Nbv(C.GLuint(indx), (*C.GLbyte)(&values[0]))
+}
+
+func (indx AttribLocation) Attrib4Niv(values *[4]int32) {
+	C.glVertexAttrib4Niv(C.GLuint(indx), (*C.GLint)(&values[0]))
+}
+
+func (indx AttribLocation) Attrib4Nsv(values *[4]int16) {
+	C.glVertexAttrib4Nsv(C.GLuint(indx), (*C.GLshort)(&values[0]))
+}
+
+func (indx AttribLocation) Attrib4Nub(x uint8, y uint8, z uint8, w uint8) {
+	C.glVertexAttrib4Nub(C.GLuint(indx), C.GLubyte(x), C.GLubyte(y), C.GLubyte(z), C.GLubyte(w))
+}
+
+func (indx AttribLocation) Attrib4Nubv(values *[4]uint8) {
+	C.glVertexAttrib4Nubv(C.GLuint(indx), (*C.GLubyte)(&values[0]))
+}
+
+func (indx AttribLocation) Attrib4Nuiv(values *[4]uint32) {
+	C.glVertexAttrib4Nuiv(C.GLuint(indx), (*C.GLuint)(&values[0]))
+}
+
+func (indx AttribLocation) Attrib4Nusv(values *[4]uint16) {
+	C.glVertexAttrib4Nusv(C.GLuint(indx), (*C.GLushort)(&values[0]))
+}
+
+func (indx AttribLocation) AttribI1i(x int32) {
+	C.glVertexAttribI1i(C.GLuint(indx), C.GLint(x))
+}
+
+func (indx AttribLocation) AttribI2i(x int32, y int32) {
+	C.glVertexAttribI2i(C.GLuint(indx), C.GLint(x), C.GLint(y))
+}
+
+func (indx AttribLocation) AttribI3i(x int32, y int32, z int32) {
+	C.glVertexAttribI3i(C.GLuint(indx), C.GLint(x), C.GLint(y), C.GLint(z))
+}
+
+func (indx AttribLocation) AttribI4i(x int32, y int32, z int32, w int32) {
+	C.glVertexAttribI4i(C.GLuint(indx), C.GLint(x), C.GLint(y), C.GLint(z), C.GLint(w))
+}
+
+func (indx AttribLocation) AttribI4iv(values *[4]int32) {
+	C.glVertexAttribI4iv(C.GLuint(indx), (*C.GLint)(&values[0]))
+}
+
+func (indx AttribLocation) AttribI1ui(x uint32) {
+	C.glVertexAttribI1ui(C.GLuint(indx), C.GLuint(x))
+}
+
+func (indx AttribLocation) AttribI2ui(x uint32, y uint32) {
+	C.glVertexAttribI2ui(C.GLuint(indx), C.GLuint(x), C.GLuint(y))
+}
+
+func (indx AttribLocation) AttribI3ui(x uint32, y uint32, z uint32) {
+	C.glVertexAttribI3ui(C.GLuint(indx), C.GLuint(x), C.GLuint(y), C.GLuint(z))
+}
+
+func (indx AttribLocation) AttribI4ui(x uint32, y uint32, z uint32, w uint32) {
+	C.glVertexAttribI4ui(C.GLuint(indx), C.GLuint(x), C.GLuint(y), C.GLuint(z), C.GLuint(w))
+}
+
+func (indx AttribLocation) AttribI4uiv(values *[4]uint32) {
+	C.glVertexAttribI4uiv(C.GLuint(indx), (*C.GLuint)(&values[0]))
+}
+
+func (indx AttribLocation) AttribL1d(x float64) {
+	C.glVertexAttribL1d(C.GLuint(indx), C.GLdouble(x))
+}
+
+func (indx AttribLocation) AttribL1dv(values *[1]float64) {
+	C.glVertexAttribL1dv(C.GLuint(indx), (*C.GLdouble)(&values[0]))
+}
+
+func (indx AttribLocation) AttribL2d(x float64, y float64) {
+	C.glVertexAttribL2d(C.GLuint(indx), C.GLdouble(x), C.GLdouble(y))
+}
+
+func (indx AttribLocation) AttribL2dv(values *[2]float64) {
+	C.glVertexAttribL2dv(C.GLuint(indx), (*C.GLdouble)(&values[0]))
+}
+
+func (indx AttribLocation) AttribL3d(x float64, y float64, z float64) {
+	C.glVertexAttribL3d(C.GLuint(indx), C.GLdouble(x), C.GLdouble(y), C.GLdouble(z))
+}
+
+func (indx AttribLocation) AttribL3dv(values *[3]float64) {
+	C.glVertexAttribL3dv(C.GLuint(indx), (*C.GLdouble)(&values[0]))
+}
+
+func (indx AttribLocation) AttribL4d(x float64, y float64, z float64, w float64) {
+	C.glVertexAttribL4d(C.GLuint(indx), C.GLdouble(x), C.GLdouble(y), C.GLdouble(z), C.GLdouble(w))
+}
+
+func (indx AttribLocation) AttribL4dv(values *[4]float64) {
+	C.glVertexAttribL4dv(C.GLuint(indx), (*C.GLdouble)(&values[0]))
+}
+
 func (indx AttribLocation) AttribPointer(size uint, typ GLenum, normalized bool, stride int, pointer interface{}) {
 	C.glVertexAttribPointer(C.GLuint(indx), C.GLint(size), C.GLenum(typ),
 		glBool(normalized), C.GLsizei(stride), ptr(pointer))
 }
 
+// For int, ivec and uvec inputs, the data is not converted to float
+func (indx AttribLocation) AttribIPointer(size uint, typ GLenum, stride int, pointer interface{}) {
+	C.glVertexAttribIPointer(C.GLuint(indx), C.GLint(size), C.GLenum(typ),
+		C.GLsizei(stride), ptr(pointer))
+}
+
+// For double and dvec inputs, typ must be DOUBLE
+func (indx AttribLocation) AttribLPointer(size uint, typ GLenum, stride int, pointer interface{}) {
+	C.glVertexAttribLPointer(C.GLuint(indx), C.GLint(size), C.GLenum(typ),
+		C.GLsizei(stride), ptr(pointer))
+}
+
 func (indx AttribLocation) EnableArray() {
 	C.glEnableVertexAttribArray(C.GLuint(indx))
 }
@@ -59,3 +172,53 @@ func (indx AttribLocation) DisableArray() {
 func (indx AttribLocation) AttribDivisor(divisor int) {
 	C.glVertexAttribDivisor(C.GLuint(indx), C.GLuint(divisor))
 }
+
+// void glGetVertexAttribiv(GLuint index, GLenum pname, GLint *params);
+func (indx AttribLocation) GetVertexAttribiv(pname GLenum, params []int32) {
+	if len(params) == 0 {
+		panic("Invalid params size")
+	}
+	C.glGetVertexAttribiv(C.GLuint(indx), C.GLenum(pname), (*C.GLint)(&params[0]))
+}
+
+// void glGetVertexAttribfv(GLuint index, GLenum pname, GLfloat *params);
+func (indx AttribLocation) GetVertexAttribfv(pname GLenum, params []float32) {
+	if len(params) == 0 {
+		panic("Invalid params size")
+	}
+	C.glGetVertexAttribfv(C.GLuint(indx), C.GLenum(pname), (*C.GLfloat)(&params[0]))
+}
+
+// void glGetVertexAttribIiv(GLuint index, GLenum pname, GLint *params);
+func (indx AttribLocation) GetVertexAttribIiv(pname GLenum, params []int32) {
+	if len(params) == 0 {
+		panic("Invalid params size")
+	}
+	C.glGetVertexAttribIiv(C.GLuint(indx), C.GLenum(pname), (*C.GLint)(&params[0]))
+}
+
+// void glGetVertexAttribIuiv(GLuint index, GLenum pname, GLuint *params);
+func (indx AttribLocation) GetVertexAttribIuiv(pname GLenum, params []uint32) {
+	if len(params) == 0 {
+		panic("Invalid params size")
+	}
+	C.glGetVertexAttribIuiv(C.GLuint(indx), C.GLenum(pname), (*C.GLuint)(&params[0]))
+}
+
+// void glGetVertexAttribLdv(GLuint index, GLenum pname, GLdouble *params);
+func (indx AttribLocation) GetVertexAttribLdv(pname GLenum, params []float64) {
+	if len(params) == 0 {
+		panic("Invalid params size")
+	}
+	C.glGetVertexAttribLdv(C.GLuint(indx), C.GLenum(pname), (*C.GLdouble)(&params[0]))
+}
+
+// void glGetVertexAttribPointerv(GLuint index, GLenum pname, GLvoid **pointer);
+//
+// With a buffer bound to the attribute, the pointer is an offset into it,
+// hence the uintptr.
+func (indx AttribLocation) GetVertexAttribPointerv(pname GLenum) uintptr {
+	var pointer uintptr
+	C.glGetVertexAttribPointerv(C.GLuint(indx), C.GLenum(pname), (*unsafe.Pointer)(unsafe.Pointer(&pointer)))
+	return pointer
+}
@@ -99,3 +99,72 @@ func GetBufferParameteriv(target GLenum, pname GLenum) int32 {
 	C.glGetBufferParameteriv(C.GLenum(target), C.GLenum(pname), &param)
 	return int32(param)
 }
+
+// Direct State Access (GL 4.5). These do not change any binding.
+
+// Create single buffer object, initialized as if bound
+func CreateBuffer() Buffer {
+	var b C.GLuint
+	C.glCreateBuffers(1, &b)
+	return Buffer(b)
+}
+
+// Fill slice with new buffers, initialized as if bound
+func CreateBuffers(buffers []Buffer) {
+	if len(buffers) > 0 {
+		C.glCreateBuffers(C.GLsizei(len(buffers)), (*C.GLuint)(&buffers[0]))
+	}
+}
+
+// Creates and initializes this buffer's data store
+func (buffer Buffer) Data(size int, data interface{}, usage GLenum) {
+	C.glNamedBufferData(C.GLuint(buffer), C.GLsizeiptr(size), ptr(data), C.GLenum(usage))
+}
+
+// Creates an immutable data store for this buffer, flags being a
+// combination of DYNAMIC_STORAGE_BIT, MAP_*_BIT and CLIENT_STORAGE_BIT
+func (buffer Buffer) Storage(size int, data interface{}, flags GLbitfield) {
+	C.glNamedBufferStorage(C.GLuint(buffer), C.GLsizeiptr(size), ptr(data), C.GLbitfield(flags))
+}
+
+// Update a subset of this buffer's data store
+func (buffer Buffer) SubData(offset int, size int, data interface{}) {
+	C.glNamedBufferSubData(C.GLuint(buffer), C.GLintptr(offset), C.GLsizeiptr(size), ptr(data))
+}
+
+// Returns a subset of this buffer's data store
+func (buffer Buffer) GetSubData(offset int, size int, data interface{}) {
+	C.glGetNamedBufferSubData(C.GLuint(buffer), C.GLintptr(offset), C.GLsizeiptr(size), ptr(data))
+}
+
+// Map this buffer's data store
+func (buffer Buffer) Map(access GLenum) unsafe.Pointer {
+	return unsafe.Pointer(C.glMapNamedBuffer(C.GLuint(buffer), C.GLenum(access)))
+}
+
+// Map a range of this buffer's data store
+func (buffer Buffer) MapRange(offset int, length int, access GLbitfield) unsafe.Pointer {
+	return unsafe.Pointer(C.glMapNamedBufferRange(C.GLuint(buffer), C.GLintptr(offset), C.GLsizeiptr(length), C.GLbitfield(access)))
+}
+
+// Indicate modifications to a range of a mapped buffer
+func (buffer Buffer) FlushMappedRange(offset int, length int) {
+	C.glFlushMappedNamedBufferRange(C.GLuint(buffer), C.GLintptr(offset), C.GLsizeiptr(length))
+}
+
+// Unmap this buffer's data store
+func (buffer Buffer) Unmap() bool {
+	return goBool(C.glUnmapNamedBuffer(C.GLuint(buffer)))
+}
+
+// Copy a range of this buffer's data store into another buffer
+func (buffer Buffer) CopySubData(write Buffer, readOffset int, writeOffset int, size int) {
+	C.glCopyNamedBufferSubData(C.GLuint(buffer), C.GLuint(write), C.GLintptr(readOffset), C.GLintptr(writeOffset), C.GLsizeiptr(size))
+}
+
+// Return parameters of this buffer
+func (buffer Buffer) GetParameteriv(pname GLenum) int32 {
+	var param C.GLint
+	C.glGetNamedBufferParameteriv(C.GLuint(buffer), C.GLenum(pname), &param)
+	return int32(param)
+}
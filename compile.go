@@ -0,0 +1,212 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Shader and Program Building
+
+// Severity of a Diagnostic
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+	SeverityInfo
+)
+
+func (severity Severity) String() string {
+	switch severity {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityInfo:
+		return "info"
+	}
+	return "Severity(" + strconv.Itoa(int(severity)) + ")"
+}
+
+// A message from a compiler or linker info log
+type Diagnostic struct {
+	File     int    // source string index, as set by #line
+	Name     string // name of File, when mapped by Source.Diagnostics
+	Line     int    // 0 if the message has no location
+	Column   int    // 0 if the log gives none
+	Severity Severity
+	Message  string
+}
+
+func (d Diagnostic) String() string {
+	file := d.Name
+	if file == "" {
+		file = strconv.Itoa(d.File)
+	}
+	switch {
+	case d.Line == 0:
+		return fmt.Sprintf("%v: %s", d.Severity, d.Message)
+	case d.Column == 0:
+		return fmt.Sprintf("%s:%d: %v: %s", file, d.Line, d.Severity, d.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %v: %s", file, d.Line, d.Column, d.Severity, d.Message)
+}
+
+// ShaderError is returned by NewShader when compiling fails and by
+// NewProgram when linking fails.
+type ShaderError struct {
+	Stage       GLenum // shader type, or 0 for link errors
+	Log         string // info log as returned by the GL
+	Diagnostics []Diagnostic
+}
+
+func (err *ShaderError) Error() string {
+	what := "program link"
+	if err.Stage != 0 {
+		what = stageName(err.Stage) + " compilation"
+	}
+	for _, d := range err.Diagnostics {
+		if d.Severity == SeverityError {
+			return fmt.Sprintf("gl: %s failed: %v", what, d)
+		}
+	}
+	if log := strings.TrimSpace(err.Log); log != "" {
+		return fmt.Sprintf("gl: %s failed: %s", what, log)
+	}
+	return fmt.Sprintf("gl: %s failed", what)
+}
+
+// Returns a readable name for a shader type
+func stageName(stage GLenum) string {
+	switch stage {
+	case VERTEX_SHADER:
+		return "vertex shader"
+	case TESS_CONTROL_SHADER:
+		return "tessellation control shader"
+	case TESS_EVALUATION_SHADER:
+		return "tessellation evaluation shader"
+	case GEOMETRY_SHADER:
+		return "geometry shader"
+	case FRAGMENT_SHADER:
+		return "fragment shader"
+	case COMPUTE_SHADER:
+		return "compute shader"
+	}
+	return fmt.Sprintf("shader 0x%x", stage)
+}
+
+var (
+	// Mesa: 0:12(5): error: `foo' undeclared
+	mesaDiagnostic = regexp.MustCompile(`^(\d+):(\d+)\((\d+)\): (?:preprocessor )?(error|warning|info)\s*:\s*(.*)$`)
+	// NVIDIA: 0(12) : error C1008: undefined variable "foo"
+	nvidiaDiagnostic = regexp.MustCompile(`^(\d+)\((\d+)\)\s*: (error|warning|info)(?: \w+)?\s*:\s*(.*)$`)
+	// AMD and others: ERROR: 0:12: 'foo' : undeclared identifier
+	amdDiagnostic = regexp.MustCompile(`^(ERROR|WARNING|INFO):\s*(\d+):(\d+):(?:(\d+):)?\s*(.*)$`)
+	// Link logs and the like: error: ... or ERROR: ...
+	plainDiagnostic = regexp.MustCompile(`^(?i)(error|warning|info)\s*:\s*(.*)$`)
+	// AMD's summary line, which repeats the count of the errors listed before
+	amdSummary = regexp.MustCompile(`^ERROR: \d+ compilation errors?\.`)
+)
+
+func parseSeverity(s string) Severity {
+	switch strings.ToLower(s) {
+	case "error":
+		return SeverityError
+	case "warning":
+		return SeverityWarning
+	}
+	return SeverityInfo
+}
+
+// ParseInfoLog extracts the diagnostics of a shader or program info log,
+// understanding the formats of the Mesa, NVIDIA and AMD compilers. Lines it
+// does not recognize are skipped.
+func ParseInfoLog(log string) []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, line := range strings.Split(log, "\n") {
+		line = strings.TrimSpace(line)
+		var d Diagnostic
+		if m := mesaDiagnostic.FindStringSubmatch(line); m != nil {
+			d.File, _ = strconv.Atoi(m[1])
+			d.Line, _ = strconv.Atoi(m[2])
+			d.Column, _ = strconv.Atoi(m[3])
+			d.Severity, d.Message = parseSeverity(m[4]), m[5]
+		} else if m := nvidiaDiagnostic.FindStringSubmatch(line); m != nil {
+			d.File, _ = strconv.Atoi(m[1])
+			d.Line, _ = strconv.Atoi(m[2])
+			d.Severity, d.Message = parseSeverity(m[3]), m[4]
+		} else if m := amdDiagnostic.FindStringSubmatch(line); m != nil {
+			d.File, _ = strconv.Atoi(m[2])
+			d.Line, _ = strconv.Atoi(m[3])
+			d.Column, _ = strconv.Atoi(m[4])
+			d.Severity, d.Message = parseSeverity(m[1]), m[5]
+		} else if amdSummary.MatchString(line) {
+			continue
+		} else if m := plainDiagnostic.FindStringSubmatch(line); m != nil {
+			d.Severity, d.Message = parseSeverity(m[1]), m[2]
+		} else {
+			continue
+		}
+		diagnostics = append(diagnostics, d)
+	}
+	return diagnostics
+}
+
+// NewShader creates a shader of type typ and compiles src. On failure the
+// shader is deleted and a *ShaderError is returned.
+func NewShader(typ GLenum, src string) (Shader, error) {
+	shader := CreateShader(typ)
+	shader.Source(src)
+	shader.Compile()
+	if shader.Get(COMPILE_STATUS) == 0 {
+		log := shader.GetInfoLog()
+		shader.Delete()
+		return 0, &ShaderError{Stage: typ, Log: log, Diagnostics: ParseInfoLog(log)}
+	}
+	return shader, nil
+}
+
+// NewShaderSPIRV creates a shader of type typ from the SPIR-V module spirv
+// and specializes it as Shader.Specialize does. On failure the shader is
+// deleted and a *ShaderError is returned.
+func NewShaderSPIRV(typ GLenum, spirv []byte, entryPoint string, constantIndex, constantValue []uint32) (Shader, error) {
+	shader := CreateShader(typ)
+	shader.Binary(SHADER_BINARY_FORMAT_SPIR_V, spirv)
+	shader.Specialize(entryPoint, constantIndex, constantValue)
+	if shader.Get(COMPILE_STATUS) == 0 {
+		log := shader.GetInfoLog()
+		shader.Delete()
+		return 0, &ShaderError{Stage: typ, Log: log, Diagnostics: ParseInfoLog(log)}
+	}
+	return shader, nil
+}
+
+// NewProgram links shaders into a new program, detaching them afterwards;
+// they remain the caller's to delete. On failure the program is deleted and
+// a *ShaderError is returned.
+func NewProgram(shaders ...Shader) (Program, error) {
+	return linkProgram(CreateProgram(), shaders...)
+}
+
+// Links shaders into program as NewProgram does
+func linkProgram(program Program, shaders ...Shader) (Program, error) {
+	for _, shader := range shaders {
+		program.AttachShader(shader)
+	}
+	program.Link()
+	for _, shader := range shaders {
+		program.DetachShader(shader)
+	}
+	if program.Get(LINK_STATUS) == 0 {
+		log := program.GetInfoLog()
+		program.Delete()
+		return 0, &ShaderError{Log: log, Diagnostics: ParseInfoLog(log)}
+	}
+	return program, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import (
+	"reflect"
+	"testing"
+)
+
+var infoLogTests = []struct {
+	name string
+	log  string
+	want []Diagnostic
+}{
+	{
+		name: "Mesa",
+		log: "0:3(10): error: `foo' undeclared\n" +
+			"0:3(2): error: operands to arithmetic operators must be numeric\n" +
+			"1:14(1): preprocessor error: Unterminated #if\n" +
+			"0:1(10): warning: extension `GL_ARB_foo' unsupported in vertex shader\n",
+		want: []Diagnostic{
+			{File: 0, Line: 3, Column: 10, Severity: SeverityError, Message: "`foo' undeclared"},
+			{File: 0, Line: 3, Column: 2, Severity: SeverityError, Message: "operands to arithmetic operators must be numeric"},
+			{File: 1, Line: 14, Column: 1, Severity: SeverityError, Message: "Unterminated #if"},
+			{File: 0, Line: 1, Column: 10, Severity: SeverityWarning, Message: "extension `GL_ARB_foo' unsupported in vertex shader"},
+		},
+	},
+	{
+		name: "NVIDIA",
+		log: "0(12) : error C1008: undefined variable \"foo\"\n" +
+			"2(7) : warning C7533: global variable gl_FragColor is deprecated after version 120\n" +
+			"0(20) : error C0000: syntax error, unexpected '}', expecting ',' or ';' at token \"}\"\n",
+		want: []Diagnostic{
+			{File: 0, Line: 12, Severity: SeverityError, Message: "undefined variable \"foo\""},
+			{File: 2, Line: 7, Severity: SeverityWarning, Message: "global variable gl_FragColor is deprecated after version 120"},
+			{File: 0, Line: 20, Severity: SeverityError, Message: "syntax error, unexpected '}', expecting ',' or ';' at token \"}\""},
+		},
+	},
+	{
+		name: "AMD",
+		log: "ERROR: 0:12: 'foo' : undeclared identifier \n" +
+			"WARNING: 1:4: 'bar' : unused variable \n" +
+			"ERROR: 0:5:17: 'x' : redefinition \n" +
+			"ERROR: 2 compilation errors.  No code generated.\n\n",
+		want: []Diagnostic{
+			{File: 0, Line: 12, Severity: SeverityError, Message: "'foo' : undeclared identifier"},
+			{File: 1, Line: 4, Severity: SeverityWarning, Message: "'bar' : unused variable"},
+			{File: 0, Line: 5, Column: 17, Severity: SeverityError, Message: "'x' : redefinition"},
+		},
+	},
+	{
+		name: "AMD summary only",
+		log:  "ERROR: 1 compilation error.  No code generated.\n",
+		want: nil,
+	},
+	{
+		name: "plain",
+		log: "error: linking with uncompiled/unspecialized shader\n" +
+			"WARNING: Output of vertex shader 'v' not read by fragment shader\n" +
+			"Vertex info\n" +
+			"-----------\n" +
+			"info: 3 instructions\n",
+		want: []Diagnostic{
+			{Severity: SeverityError, Message: "linking with uncompiled/unspecialized shader"},
+			{Severity: SeverityWarning, Message: "Output of vertex shader 'v' not read by fragment shader"},
+			{Severity: SeverityInfo, Message: "3 instructions"},
+		},
+	},
+	{
+		name: "CRLF and blank lines",
+		log:  "\r\n0:7(1): error: syntax error, unexpected '}'\r\n\r\n",
+		want: []Diagnostic{
+			{File: 0, Line: 7, Column: 1, Severity: SeverityError, Message: "syntax error, unexpected '}'"},
+		},
+	},
+	{
+		name: "empty",
+		log:  "",
+		want: nil,
+	},
+}
+
+func TestParseInfoLog(t *testing.T) {
+	for _, test := range infoLogTests {
+		got := ParseInfoLog(test.log)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: ParseInfoLog(%q) =\n%#v\nwant\n%#v", test.name, test.log, got, test.want)
+		}
+	}
+}
+
+func TestDiagnosticString(t *testing.T) {
+	tests := []struct {
+		d    Diagnostic
+		want string
+	}{
+		{Diagnostic{File: 0, Line: 3, Column: 10, Severity: SeverityError, Message: "m"}, "0:3:10: error: m"},
+		{Diagnostic{File: 2, Line: 7, Severity: SeverityWarning, Message: "m"}, "2:7: warning: m"},
+		{Diagnostic{File: 1, Name: "lib/noise.glsl", Line: 4, Severity: SeverityError, Message: "m"}, "lib/noise.glsl:4: error: m"},
+		{Diagnostic{Severity: SeverityInfo, Message: "m"}, "info: m"},
+	}
+	for _, test := range tests {
+		if got := test.d.String(); got != test.want {
+			t.Errorf("%#v.String() = %q, want %q", test.d, got, test.want)
+		}
+	}
+}
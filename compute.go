@@ -0,0 +1,67 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+// #include "gl.h"
+import "C"
+import "fmt"
+
+// Compute Shaders
+
+// Mirrors DispatchIndirectCommand, as read by DispatchComputeIndirect
+type DispatchIndirectCommand struct {
+	NumGroupsX uint32
+	NumGroupsY uint32
+	NumGroupsZ uint32
+}
+
+// Returns the local size of a linked compute program, as declared by its
+// layout(local_size_x = ...) qualifiers
+func (program Program) GetComputeWorkGroupSize() [3]int {
+	var size [3]C.GLint
+	C.glGetProgramiv(C.GLuint(program), C.GLenum(COMPUTE_WORK_GROUP_SIZE), &size[0])
+	return [3]int{int(size[0]), int(size[1]), int(size[2])}
+}
+
+// WorkGroups returns the number of work groups program must be dispatched
+// with to run at least one invocation for every item of an x by y by z
+// problem. Shaders must skip the invocations past the edges, as in
+//
+//	if (any(greaterThanEqual(gl_GlobalInvocationID, size))) return;
+//
+// Dimensions of 0 are taken as 1. A problem needing more work groups along
+// an axis than MAX_COMPUTE_WORK_GROUP_COUNT allows is an error.
+func (program Program) WorkGroups(x, y, z int) (numGroupsX, numGroupsY, numGroupsZ uint, err error) {
+	var max [3]int
+	for axis := range max {
+		var count [1]int32
+		GetIntegeri_v(MAX_COMPUTE_WORK_GROUP_COUNT, uint(axis), count[:])
+		max[axis] = int(count[0])
+	}
+	groups, err := workGroupCounts([3]int{x, y, z}, program.GetComputeWorkGroupSize(), max)
+	return groups[0], groups[1], groups[2], err
+}
+
+// Divides problem by local along every axis, rounding up, and checks the
+// counts against max
+func workGroupCounts(problem, local, max [3]int) ([3]uint, error) {
+	var groups [3]uint
+	for axis := range groups {
+		n, l := problem[axis], local[axis]
+		if n < 1 {
+			n = 1
+		}
+		if l < 1 {
+			l = 1
+		}
+		count := (n + l - 1) / l
+		if count > max[axis] {
+			return [3]uint{}, fmt.Errorf("gl: %d items along axis %d need %d work groups of %d, at most %d can be dispatched",
+				problem[axis], axis, count, l, max[axis])
+		}
+		groups[axis] = uint(count)
+	}
+	return groups, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWorkGroupCounts(t *testing.T) {
+	max := [3]int{65535, 65535, 65535}
+	tests := []struct {
+		problem, local [3]int
+		want           [3]uint
+	}{
+		{[3]int{1000, 3, 1}, [3]int{64, 2, 1}, [3]uint{16, 2, 1}},
+		{[3]int{1024, 4, 1}, [3]int{64, 2, 1}, [3]uint{16, 2, 1}},
+		{[3]int{1025, 5, 1}, [3]int{64, 2, 1}, [3]uint{17, 3, 1}},
+		{[3]int{1, 1, 1}, [3]int{256, 1, 1}, [3]uint{1, 1, 1}},
+		// Dimensions of 0 are taken as 1
+		{[3]int{0, 0, 0}, [3]int{8, 8, 8}, [3]uint{1, 1, 1}},
+		{[3]int{10, -1, 0}, [3]int{0, 1, 1}, [3]uint{10, 1, 1}},
+		// Exactly at the limit
+		{[3]int{65535 * 32, 1, 1}, [3]int{32, 1, 1}, [3]uint{65535, 1, 1}},
+	}
+	for _, test := range tests {
+		got, err := workGroupCounts(test.problem, test.local, max)
+		if err != nil {
+			t.Errorf("workGroupCounts(%v, %v): %v", test.problem, test.local, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("workGroupCounts(%v, %v) = %v, want %v", test.problem, test.local, got, test.want)
+		}
+	}
+}
+
+func TestWorkGroupCountsLimit(t *testing.T) {
+	max := [3]int{65535, 65535, 64}
+	tests := []struct {
+		problem, local [3]int
+		axis           string
+	}{
+		{[3]int{65535*32 + 1, 1, 1}, [3]int{32, 1, 1}, "axis 0"},
+		{[3]int{1, 70000, 1}, [3]int{1, 1, 1}, "axis 1"},
+		{[3]int{1, 1, 65}, [3]int{1, 1, 1}, "axis 2"},
+	}
+	for _, test := range tests {
+		got, err := workGroupCounts(test.problem, test.local, max)
+		if err == nil {
+			t.Errorf("workGroupCounts(%v, %v) = %v, want an error", test.problem, test.local, got)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), "gl: ") || !strings.Contains(err.Error(), test.axis) {
+			t.Errorf("workGroupCounts(%v, %v): error %q does not name %s", test.problem, test.local, err, test.axis)
+		}
+		if got != [3]uint{} {
+			t.Errorf("workGroupCounts(%v, %v) = %v with an error", test.problem, test.local, got)
+		}
+	}
+}
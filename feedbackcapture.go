@@ -0,0 +1,122 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Transform Feedback Capture
+
+// Returns the bytes one vertex of the transform feedback varyings of program
+// takes in an interleaved buffer
+func transformFeedbackStride(program Program) (int, error) {
+	if n := program.Get(TRANSFORM_FEEDBACK_VARYINGS); n > 1 && program.Get(TRANSFORM_FEEDBACK_BUFFER_MODE) != INTERLEAVED_ATTRIBS {
+		return 0, fmt.Errorf("gl: program %d captures into separate buffers", program)
+	}
+	stride := 0
+	for i := 0; i < program.Get(TRANSFORM_FEEDBACK_VARYINGS); i++ {
+		size, typ, name := program.GetTransformFeedbackVarying(i)
+		switch {
+		case name == "gl_NextBuffer":
+			return 0, fmt.Errorf("gl: program %d captures into several buffers", program)
+		case strings.HasPrefix(name, "gl_SkipComponents"):
+			stride += 4 * size
+			continue
+		}
+		kind, columns, rows := glTypeShape(typ)
+		if kind == reflect.Invalid {
+			return 0, fmt.Errorf("gl: cannot capture %s varying %s", GLSLTypeName(typ), name)
+		}
+		stride += size * columns * rows * componentSize(kind)
+	}
+	if stride == 0 {
+		return 0, fmt.Errorf("gl: program %d has no transform feedback varyings", program)
+	}
+	return stride, nil
+}
+
+// Capture runs draw with rasterizer discard and captures the transform
+// feedback varyings of the current program into vertices, a slice of
+// structs or arrays laid out like the interleaved varyings of one vertex.
+// Its length is the number of vertices there is room for. primitiveMode is
+// the mode passed to BeginTransformFeedback: POINTS, LINES or TRIANGLES.
+//
+// Capture returns the number of vertices written, counted with a
+// TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN query. The draw overflowing
+// vertices is reported as an error; the vertices that fit are still
+// returned. The bound transform feedback and the RASTERIZER_DISCARD state
+// are restored before returning.
+func Capture(primitiveMode GLenum, vertices interface{}, draw func()) (int, error) {
+	v := reflect.ValueOf(vertices)
+	if v.Kind() != reflect.Slice || v.Len() == 0 {
+		return 0, fmt.Errorf("gl: %T is not a slice with room for vertices", vertices)
+	}
+	var perPrimitive int
+	switch primitiveMode {
+	case POINTS:
+		perPrimitive = 1
+	case LINES:
+		perPrimitive = 2
+	case TRIANGLES:
+		perPrimitive = 3
+	default:
+		return 0, fmt.Errorf("gl: invalid transform feedback primitive mode 0x%x", primitiveMode)
+	}
+
+	var current [1]int32
+	GetIntegerv(CURRENT_PROGRAM, current[:])
+	if current[0] == 0 {
+		return 0, fmt.Errorf("gl: no program in use")
+	}
+	program := Program(current[0])
+	stride, err := transformFeedbackStride(program)
+	if err != nil {
+		return 0, err
+	}
+	if size := int(v.Type().Elem().Size()); size != stride {
+		return 0, fmt.Errorf("gl: %v takes %d bytes, the varyings of program %d take %d", v.Type().Elem(), size, program, stride)
+	}
+
+	var previous [1]int32
+	GetIntegerv(TRANSFORM_FEEDBACK_BINDING, previous[:])
+	discard := IsEnabled(RASTERIZER_DISCARD)
+
+	feedback := GenTransformFeedback()
+	defer feedback.Delete()
+	feedback.Bind(TRANSFORM_FEEDBACK)
+	buffer := CreateBuffer()
+	defer buffer.Delete()
+	buffer.Storage(v.Len()*stride, nil, 0)
+	feedback.BufferBase(0, buffer)
+	queries := make([]Query, 2)
+	GenQueries(queries)
+	defer DeleteQueries(queries)
+	written, generated := queries[0], queries[1]
+
+	Enable(RASTERIZER_DISCARD)
+	written.Begin(TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN)
+	generated.Begin(PRIMITIVES_GENERATED)
+	BeginTransformFeedback(primitiveMode)
+	draw()
+	EndTransformFeedback()
+	EndQuery(PRIMITIVES_GENERATED)
+	EndQuery(TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN)
+	if !discard {
+		Disable(RASTERIZER_DISCARD)
+	}
+	TransformFeedback(previous[0]).Bind(TRANSFORM_FEEDBACK)
+
+	n := int(written.GetObjectuiv(QUERY_RESULT)) * perPrimitive
+	if n > 0 {
+		buffer.GetSubData(0, n*stride, vertices)
+	}
+	if all := int(generated.GetObjectuiv(QUERY_RESULT)); all*perPrimitive > n {
+		return n, fmt.Errorf("gl: the draw generated %d vertices, only %d were captured for lack of room", all*perPrimitive, n)
+	}
+	return n, nil
+}
@@ -92,3 +92,58 @@ func GenFramebuffers(bufs []Framebuffer) {
 		C.glGenFramebuffers(C.GLsizei(len(bufs)), (*C.GLuint)(&bufs[0]))
 	}
 }
+
+// Direct State Access (GL 4.5). These do not change the bound framebuffers.
+
+// void glCreateFramebuffers(GLsizei n, GLuint *framebuffers);
+func CreateFramebuffer() Framebuffer {
+	var b C.GLuint
+	C.glCreateFramebuffers(1, &b)
+	return Framebuffer(b)
+}
+
+func CreateFramebuffers(bufs []Framebuffer) {
+	if len(bufs) > 0 {
+		C.glCreateFramebuffers(C.GLsizei(len(bufs)), (*C.GLuint)(&bufs[0]))
+	}
+}
+
+// void glNamedFramebufferTexture(GLuint framebuffer, GLenum attachment, GLuint texture, GLint level);
+func (fb Framebuffer) Texture(attachment GLenum, texture Texture, level int) {
+	C.glNamedFramebufferTexture(C.GLuint(fb), C.GLenum(attachment), C.GLuint(texture), C.GLint(level))
+}
+
+// void glNamedFramebufferTextureLayer(GLuint framebuffer, GLenum attachment, GLuint texture, GLint level, GLint layer);
+func (fb Framebuffer) TextureLayer(attachment GLenum, texture Texture, level, layer int) {
+	C.glNamedFramebufferTextureLayer(C.GLuint(fb), C.GLenum(attachment), C.GLuint(texture), C.GLint(level), C.GLint(layer))
+}
+
+// void glNamedFramebufferRenderbuffer(GLuint framebuffer, GLenum attachment, GLenum renderbuffertarget, GLuint renderbuffer);
+func (fb Framebuffer) Renderbuffer(attachment GLenum, rb Renderbuffer) {
+	C.glNamedFramebufferRenderbuffer(C.GLuint(fb), C.GLenum(attachment), C.GLenum(RENDERBUFFER), C.GLuint(rb))
+}
+
+// void glNamedFramebufferDrawBuffer(GLuint framebuffer, GLenum buf);
+func (fb Framebuffer) DrawBuffer(buf GLenum) {
+	C.glNamedFramebufferDrawBuffer(C.GLuint(fb), C.GLenum(buf))
+}
+
+// void glNamedFramebufferDrawBuffers(GLuint framebuffer, GLsizei n, const GLenum *bufs);
+func (fb Framebuffer) DrawBuffers(bufs []GLenum) {
+	if len(bufs) == 0 {
+		panic("Invalid bufs slice length")
+	}
+	C.glNamedFramebufferDrawBuffers(C.GLuint(fb), C.GLsizei(len(bufs)), (*C.GLenum)(&bufs[0]))
+}
+
+// void glNamedFramebufferReadBuffer(GLuint framebuffer, GLenum src);
+func (fb Framebuffer) ReadBuffer(src GLenum) {
+	C.glNamedFramebufferReadBuffer(C.GLuint(fb), C.GLenum(src))
+}
+
+// GLenum glCheckNamedFramebufferStatus(GLuint framebuffer, GLenum target);
+//
+// Returns the status fb would have if bound to target.
+func (fb Framebuffer) CheckStatus(target GLenum) GLenum {
+	return (GLenum)(C.glCheckNamedFramebufferStatus(C.GLuint(fb), C.GLenum(target)))
+}
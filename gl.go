@@ -231,16 +231,39 @@ func DisableClientState(array GLenum) {
 	C.glDisableClientState(C.GLenum(array))
 }
 
+//void glDispatchCompute(GLuint num_groups_x, GLuint num_groups_y, GLuint num_groups_z)
+func DispatchCompute(numGroupsX, numGroupsY, numGroupsZ uint) {
+	C.glDispatchCompute(C.GLuint(numGroupsX), C.GLuint(numGroupsY), C.GLuint(numGroupsZ))
+}
+
+//void glDispatchComputeIndirect(GLintptr indirect)
+//
+// indirect is a byte offset into the bound DISPATCH_INDIRECT_BUFFER, which
+// holds a DispatchIndirectCommand.
+func DispatchComputeIndirect(indirect int) {
+	C.glDispatchComputeIndirect(C.GLintptr(indirect))
+}
+
 //void glDrawArrays (GLenum mode, int first, int count)
 func DrawArrays(mode GLenum, first int, count int) {
 	C.glDrawArrays(C.GLenum(mode), C.GLint(first), C.GLsizei(count))
 }
 
+//void glDrawArraysIndirect(GLenum mode, const void *indirect)
+func DrawArraysIndirect(mode GLenum, indirect interface{}) {
+	C.glDrawArraysIndirect(C.GLenum(mode), ptr(indirect))
+}
+
 //void glDrawArraysInstanced(GLenum mode,  GLint first,  GLsizei count,  GLsizei primcount)
 func DrawArraysInstanced(mode GLenum, first int, count, primcount int) {
 	C.glDrawArraysInstanced(C.GLenum(mode), C.GLint(first), C.GLsizei(count), C.GLsizei(primcount))
 }
 
+//void glDrawArraysInstancedBaseInstance(GLenum mode, GLint first, GLsizei count, GLsizei primcount, GLuint baseinstance)
+func DrawArraysInstancedBaseInstance(mode GLenum, first int, count, primcount int, baseinstance uint) {
+	C.glDrawArraysInstancedBaseInstance(C.GLenum(mode), C.GLint(first), C.GLsizei(count), C.GLsizei(primcount), C.GLuint(baseinstance))
+}
+
 //void glDrawBuffer (GLenum mode)
 func DrawBuffer(mode GLenum) {
 	C.glDrawBuffer(C.GLenum(mode))
@@ -263,18 +286,53 @@ func DrawElementsInstanced(mode GLenum, count int, typ GLenum, indices interface
 		ptr(indices), C.GLsizei(primcount))
 }
 
+//void glDrawElementsInstancedBaseInstance(GLenum mode, GLsizei count, GLenum type, const void *indices, GLsizei primcount, GLuint baseinstance)
+func DrawElementsInstancedBaseInstance(mode GLenum, count int, typ GLenum, indices interface{}, primcount int, baseinstance uint) {
+	C.glDrawElementsInstancedBaseInstance(C.GLenum(mode), C.GLsizei(count), C.GLenum(typ),
+		ptr(indices), C.GLsizei(primcount), C.GLuint(baseinstance))
+}
+
+//void glDrawElementsInstancedBaseVertex(GLenum mode, GLsizei count, GLenum type, const void *indices, GLsizei primcount, GLint basevertex)
+func DrawElementsInstancedBaseVertex(mode GLenum, count int, typ GLenum, indices interface{}, primcount int, basevertex int) {
+	C.glDrawElementsInstancedBaseVertex(C.GLenum(mode), C.GLsizei(count), C.GLenum(typ),
+		ptr(indices), C.GLsizei(primcount), C.GLint(basevertex))
+}
+
+//void glDrawElementsInstancedBaseVertexBaseInstance(GLenum mode, GLsizei count, GLenum type, const void *indices, GLsizei primcount, GLint basevertex, GLuint baseinstance)
+func DrawElementsInstancedBaseVertexBaseInstance(mode GLenum, count int, typ GLenum, indices interface{}, primcount int, basevertex int, baseinstance uint) {
+	C.glDrawElementsInstancedBaseVertexBaseInstance(C.GLenum(mode), C.GLsizei(count), C.GLenum(typ),
+		ptr(indices), C.GLsizei(primcount), C.GLint(basevertex), C.GLuint(baseinstance))
+}
+
 //void glDrawElementsBaseVertex(GLenum mode, int count, GLenum type, GLvoid *indices, int basevertex)
 func DrawElementsBaseVertex(mode GLenum, count int, typ GLenum, indices interface{}, basevertex int) {
 	C.glDrawElementsBaseVertex(C.GLenum(mode), C.GLsizei(count),
 		C.GLenum(typ), ptr(indices), C.GLint(basevertex))
 }
 
+//void glDrawElementsIndirect(GLenum mode, GLenum type, const void *indirect)
+func DrawElementsIndirect(mode GLenum, typ GLenum, indirect interface{}) {
+	C.glDrawElementsIndirect(C.GLenum(mode), C.GLenum(typ), ptr(indirect))
+}
+
 //void glDrawPixels (GLsizei width, int height, GLenum format, GLenum type, const GLvoid *pixels)
 func DrawPixels(width int, height int, format, typ GLenum, pixels interface{}) {
 	C.glDrawPixels(C.GLsizei(width), C.GLsizei(height), C.GLenum(format),
 		C.GLenum(typ), ptr(pixels))
 }
 
+//void glDrawRangeElements(GLenum mode, GLuint start, GLuint end, GLsizei count, GLenum type, const void *indices)
+func DrawRangeElements(mode GLenum, start, end uint, count int, typ GLenum, indices interface{}) {
+	C.glDrawRangeElements(C.GLenum(mode), C.GLuint(start), C.GLuint(end), C.GLsizei(count),
+		C.GLenum(typ), ptr(indices))
+}
+
+//void glDrawRangeElementsBaseVertex(GLenum mode, GLuint start, GLuint end, GLsizei count, GLenum type, const void *indices, GLint basevertex)
+func DrawRangeElementsBaseVertex(mode GLenum, start, end uint, count int, typ GLenum, indices interface{}, basevertex int) {
+	C.glDrawRangeElementsBaseVertex(C.GLenum(mode), C.GLuint(start), C.GLuint(end), C.GLsizei(count),
+		C.GLenum(typ), ptr(indices), C.GLint(basevertex))
+}
+
 //void glEdgeFlag (bool flag)
 func EdgeFlag(flag bool) {
 	C.glEdgeFlag(glBool(flag))
@@ -465,6 +523,22 @@ func GetIntegerv(pname GLenum, params []int32) {
 	C.glGetIntegerv(C.GLenum(pname), (*C.GLint)(&params[0]))
 }
 
+//void glGetIntegeri_v (GLenum target, GLuint index, GLint *data)
+func GetIntegeri_v(target GLenum, index uint, data []int32) {
+	if len(data) == 0 {
+		panic("Invalid data length")
+	}
+	C.glGetIntegeri_v(C.GLenum(target), C.GLuint(index), (*C.GLint)(&data[0]))
+}
+
+//void glGetInteger64i_v (GLenum target, GLuint index, GLint64 *data)
+func GetInteger64i_v(target GLenum, index uint, data []int64) {
+	if len(data) == 0 {
+		panic("Invalid data length")
+	}
+	C.glGetInteger64i_v(C.GLenum(target), C.GLuint(index), (*C.GLint64)(&data[0]))
+}
+
 //void glGetLightfv (GLenum light, GLenum pname, float *params)
 func GetLightfv(light GLenum, pname GLenum, params []float32) {
 	if len(params) == 0 {
@@ -804,6 +878,66 @@ func Materialiv(face GLenum, pname GLenum, params []int32) {
 	C.glMaterialiv(C.GLenum(face), C.GLenum(pname), (*C.GLint)(&params[0]))
 }
 
+//void glMemoryBarrier(GLbitfield barriers)
+func MemoryBarrier(barriers GLbitfield) {
+	C.glMemoryBarrier(C.GLbitfield(barriers))
+}
+
+//void glMemoryBarrierByRegion(GLbitfield barriers)
+func MemoryBarrierByRegion(barriers GLbitfield) {
+	C.glMemoryBarrierByRegion(C.GLbitfield(barriers))
+}
+
+//void glMultiDrawArrays(GLenum mode, const GLint *first, const GLsizei *count, GLsizei drawcount)
+//
+// Draws len(first) ranges, first and count must have the same length.
+func MultiDrawArrays(mode GLenum, first []int32, count []int32) {
+	if len(first) != len(count) {
+		panic("First slice must be equal in length to count slice.")
+	}
+	if len(first) > 0 {
+		C.glMultiDrawArrays(C.GLenum(mode), (*C.GLint)(&first[0]), (*C.GLsizei)(&count[0]), C.GLsizei(len(first)))
+	}
+}
+
+//void glMultiDrawArraysIndirect(GLenum mode, const void *indirect, GLsizei drawcount, GLsizei stride)
+func MultiDrawArraysIndirect(mode GLenum, indirect interface{}, drawcount int, stride int) {
+	C.glMultiDrawArraysIndirect(C.GLenum(mode), ptr(indirect), C.GLsizei(drawcount), C.GLsizei(stride))
+}
+
+//void glMultiDrawElements(GLenum mode, const GLsizei *count, GLenum type, const void *const *indices, GLsizei drawcount)
+//
+// indices are byte offsets into the bound ELEMENT_ARRAY_BUFFER, count and
+// indices must have the same length.
+func MultiDrawElements(mode GLenum, count []int32, typ GLenum, indices []uintptr) {
+	if len(count) != len(indices) {
+		panic("Count slice must be equal in length to indices slice.")
+	}
+	if len(count) > 0 {
+		C.glMultiDrawElements(C.GLenum(mode), (*C.GLsizei)(&count[0]), C.GLenum(typ),
+			(*unsafe.Pointer)(unsafe.Pointer(&indices[0])), C.GLsizei(len(count)))
+	}
+}
+
+//void glMultiDrawElementsBaseVertex(GLenum mode, const GLsizei *count, GLenum type, const void *const *indices, GLsizei drawcount, const GLint *basevertex)
+//
+// indices are byte offsets into the bound ELEMENT_ARRAY_BUFFER, count,
+// indices and basevertex must have the same length.
+func MultiDrawElementsBaseVertex(mode GLenum, count []int32, typ GLenum, indices []uintptr, basevertex []int32) {
+	if len(count) != len(indices) || len(count) != len(basevertex) {
+		panic("Count, indices and basevertex slices must be equal in length.")
+	}
+	if len(count) > 0 {
+		C.glMultiDrawElementsBaseVertex(C.GLenum(mode), (*C.GLsizei)(&count[0]), C.GLenum(typ),
+			(*unsafe.Pointer)(unsafe.Pointer(&indices[0])), C.GLsizei(len(count)), (*C.GLint)(&basevertex[0]))
+	}
+}
+
+//void glMultiDrawElementsIndirect(GLenum mode, GLenum type, const void *indirect, GLsizei drawcount, GLsizei stride)
+func MultiDrawElementsIndirect(mode GLenum, typ GLenum, indirect interface{}, drawcount int, stride int) {
+	C.glMultiDrawElementsIndirect(C.GLenum(mode), C.GLenum(typ), ptr(indirect), C.GLsizei(drawcount), C.GLsizei(stride))
+}
+
 //void glNewList (uint list, GLenum mode)
 func NewList(list uint, mode GLenum) {
 	C.glNewList(C.GLuint(list), C.GLenum(mode))
@@ -869,6 +1003,29 @@ func PassThrough(token float32) {
 	C.glPassThrough(C.GLfloat(token))
 }
 
+//void glPatchParameteri(GLenum pname, GLint value)
+func PatchParameteri(pname GLenum, value int) {
+	C.glPatchParameteri(C.GLenum(pname), C.GLint(value))
+}
+
+//void glPatchParameterfv(GLenum pname, const GLfloat *values)
+//
+// Sets PATCH_DEFAULT_OUTER_LEVEL from 4 values or PATCH_DEFAULT_INNER_LEVEL
+// from 2, used when there is no tessellation control shader.
+func PatchParameterfv(pname GLenum, values []float32) {
+	n := 1
+	switch pname {
+	case PATCH_DEFAULT_OUTER_LEVEL:
+		n = 4
+	case PATCH_DEFAULT_INNER_LEVEL:
+		n = 2
+	}
+	if len(values) < n {
+		panic("Invalid values length")
+	}
+	C.glPatchParameterfv(C.GLenum(pname), (*C.GLfloat)(&values[0]))
+}
+
 //void glPixelStoref (GLenum pname, float param)
 func PixelStoref(pname GLenum, param float32) {
 	C.glPixelStoref(C.GLenum(pname), C.GLfloat(param))
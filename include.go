@@ -0,0 +1,186 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// GLSL #include Preprocessing
+
+// Source is GLSL code assembled by Preprocess. Every file it was made of is
+// numbered by #line directives, so compilers report the original lines.
+type Source struct {
+	Code  string
+	Files []string // file names, indexed by source string number
+}
+
+var (
+	includeDirective = regexp.MustCompile(`^\s*#\s*include\s*(?:"([^"]+)"|<([^>]+)>)\s*(?://.*)?$`)
+	onceDirective    = regexp.MustCompile(`^\s*#\s*pragma\s+once\s*(?://.*)?$`)
+	versionDirective = regexp.MustCompile(`^\s*#\s*version\b`)
+	ifndefDirective  = regexp.MustCompile(`^\s*#\s*ifndef\s+(\w+)\s*$`)
+	defineDirective  = regexp.MustCompile(`^\s*#\s*define\s+(\w+)\s*$`)
+	endifDirective   = regexp.MustCompile(`^\s*#\s*endif\b`)
+)
+
+type preprocessor struct {
+	fsys  fs.FS
+	src   *Source
+	index map[string]int  // source string number of each file read
+	once  map[string]bool // files included at most once
+	stack []string        // files being processed, outermost first
+	out   strings.Builder
+}
+
+// Preprocess reads the GLSL file name from fsys and replaces its
+// #include "file" and #include <file> directives by the contents of the
+// files named. Quoted names are relative to the including file, bracketed
+// ones to the root of fsys. A file is included only once if it has a
+// #pragma once directive or is wrapped in an #ifndef/#define/#endif guard.
+// #version directives of included files are dropped. The #line directives
+// follow GLSL 3.30 and later, which number the line after them.
+func Preprocess(fsys fs.FS, name string) (*Source, error) {
+	p := &preprocessor{
+		fsys:  fsys,
+		src:   &Source{},
+		index: make(map[string]int),
+		once:  make(map[string]bool),
+	}
+	if err := p.include(path.Clean(name), "", 0); err != nil {
+		return nil, err
+	}
+	p.src.Code = p.out.String()
+	return p.src, nil
+}
+
+// Appends file name, included from line of file from
+func (p *preprocessor) include(name, from string, line int) error {
+	n, seen := p.index[name]
+	if seen && p.once[name] {
+		return nil
+	}
+	for _, f := range p.stack {
+		if f == name {
+			return fmt.Errorf("gl: %s:%d: include cycle: %s -> %s", from, line, strings.Join(p.stack, " -> "), name)
+		}
+	}
+	b, err := fs.ReadFile(p.fsys, name)
+	if err != nil {
+		if from == "" {
+			return fmt.Errorf("gl: %v", err)
+		}
+		return fmt.Errorf("gl: %s:%d: %v", from, line, err)
+	}
+	if !seen {
+		n = len(p.src.Files)
+		p.index[name] = n
+		p.src.Files = append(p.src.Files, name)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(b), "\n"), "\n")
+	if includeGuard(lines) {
+		p.once[name] = true
+	}
+
+	p.stack = append(p.stack, name)
+	defer func() { p.stack = p.stack[:len(p.stack)-1] }()
+
+	// The #version of the top file must come first, so its lines are only
+	// numbered after it
+	if from != "" {
+		p.line(1, n)
+	}
+	for i, text := range lines {
+		switch {
+		case versionDirective.MatchString(text):
+			if from == "" {
+				p.out.WriteString(text + "\n")
+				p.line(i+2, n)
+			} else {
+				p.out.WriteString("\n")
+			}
+		case onceDirective.MatchString(text):
+			p.once[name] = true
+			p.out.WriteString("\n")
+		case includeDirective.MatchString(text):
+			m := includeDirective.FindStringSubmatch(text)
+			target := path.Join(path.Dir(name), m[1])
+			if m[1] == "" {
+				target = path.Clean(m[2])
+			}
+			if err := p.include(target, name, i+1); err != nil {
+				return err
+			}
+			p.line(i+2, n)
+		default:
+			p.out.WriteString(text + "\n")
+		}
+	}
+	return nil
+}
+
+// Writes a #line directive numbering the next line
+func (p *preprocessor) line(line, file int) {
+	p.out.WriteString("#line " + strconv.Itoa(line) + " " + strconv.Itoa(file) + "\n")
+}
+
+// Reports whether lines are wrapped in a classic include guard:
+//
+//	#ifndef NAME
+//	#define NAME
+//	...
+//	#endif
+func includeGuard(lines []string) bool {
+	var code []string
+	for _, line := range lines {
+		if t := strings.TrimSpace(line); t != "" && !strings.HasPrefix(t, "//") {
+			code = append(code, t)
+		}
+	}
+	if len(code) < 3 || !endifDirective.MatchString(code[len(code)-1]) {
+		return false
+	}
+	ifndef := ifndefDirective.FindStringSubmatch(code[0])
+	define := defineDirective.FindStringSubmatch(code[1])
+	return ifndef != nil && define != nil && ifndef[1] == define[1]
+}
+
+// Diagnostics parses an info log of a shader compiled from src, naming the
+// file of every diagnostic.
+func (src *Source) Diagnostics(log string) []Diagnostic {
+	diagnostics := ParseInfoLog(log)
+	for i := range diagnostics {
+		d := &diagnostics[i]
+		if d.Line > 0 && d.File >= 0 && d.File < len(src.Files) {
+			d.Name = src.Files[d.File]
+		}
+	}
+	return diagnostics
+}
+
+// NewShaderFS preprocesses file name of fsys and compiles it into a new
+// shader of type typ, as NewShader does. The diagnostics of a *ShaderError
+// name the file they refer to.
+func NewShaderFS(typ GLenum, fsys fs.FS, name string) (Shader, error) {
+	src, err := Preprocess(fsys, name)
+	if err != nil {
+		return 0, err
+	}
+	return src.compile(typ)
+}
+
+// Compiles src as NewShader does, mapping the diagnostics of errors
+func (src *Source) compile(typ GLenum) (Shader, error) {
+	shader, err := NewShader(typ, src.Code)
+	if err, ok := err.(*ShaderError); ok {
+		err.Diagnostics = src.Diagnostics(err.Log)
+	}
+	return shader, err
+}
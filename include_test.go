@@ -0,0 +1,169 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+var includeFS = fstest.MapFS{
+	"main.frag": {Data: []byte(`#version 330
+#include "lib/util.glsl"
+#include <common.glsl>
+void main() {}
+`)},
+	"lib/util.glsl": {Data: []byte(`#pragma once
+#include "math.glsl"
+float util() { return 1.0; }
+`)},
+	"lib/math.glsl": {Data: []byte(`#ifndef MATH_GLSL
+#define MATH_GLSL
+float pi() { return 3.14; }
+#endif
+`)},
+	"common.glsl": {Data: []byte(`#version 330
+#include "lib/util.glsl"
+#include "lib/math.glsl" // guarded
+float common() { return pi(); }
+`)},
+	"cycle/a.glsl": {Data: []byte(`float a();
+#include "b.glsl"
+`)},
+	"cycle/b.glsl": {Data: []byte(`#include <cycle/a.glsl>
+`)},
+	"missing.frag": {Data: []byte(`#version 330
+#include "lib/missing.glsl"
+`)},
+}
+
+func TestPreprocess(t *testing.T) {
+	src, err := Preprocess(includeFS, "main.frag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantFiles := []string{"main.frag", "lib/util.glsl", "lib/math.glsl", "common.glsl"}
+	if !reflect.DeepEqual(src.Files, wantFiles) {
+		t.Errorf("Files = %q, want %q", src.Files, wantFiles)
+	}
+	// lib/util.glsl has #pragma once and lib/math.glsl an include guard, so
+	// common.glsl includes neither again
+	wantCode := `#version 330
+#line 2 0
+#line 1 1
+
+#line 1 2
+#ifndef MATH_GLSL
+#define MATH_GLSL
+float pi() { return 3.14; }
+#endif
+#line 3 1
+float util() { return 1.0; }
+#line 3 0
+#line 1 3
+
+#line 3 3
+#line 4 3
+float common() { return pi(); }
+#line 4 0
+void main() {}
+`
+	if src.Code != wantCode {
+		t.Errorf("Code =\n%s\nwant\n%s", src.Code, wantCode)
+	}
+	checkLineDirectives(t, src)
+}
+
+// Checks that every line of src.Code is the line of the file the #line
+// directives before it number it as
+func checkLineDirectives(t *testing.T, src *Source) {
+	line, file := 1, 0
+	for _, text := range strings.Split(strings.TrimSuffix(src.Code, "\n"), "\n") {
+		var l, f int
+		if n, _ := fmt.Sscanf(text, "#line %d %d", &l, &f); n == 2 {
+			line, file = l, f
+			continue
+		}
+		data := string(includeFS[src.Files[file]].Data)
+		lines := strings.Split(data, "\n")
+		if line > len(lines) {
+			t.Errorf("%q is numbered %s:%d, past the end", text, src.Files[file], line)
+		} else if orig := lines[line-1]; text != "" && text != orig {
+			t.Errorf("%q is numbered %s:%d, which is %q", text, src.Files[file], line, orig)
+		}
+		line++
+	}
+}
+
+func TestPreprocessErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"cycle/a.glsl", "gl: cycle/b.glsl:1: include cycle: cycle/a.glsl -> cycle/b.glsl -> cycle/a.glsl"},
+		{"missing.frag", "gl: missing.frag:2: open lib/missing.glsl: file does not exist"},
+		{"nothing.frag", "gl: open nothing.frag: file does not exist"},
+	}
+	for _, test := range tests {
+		src, err := Preprocess(includeFS, test.name)
+		if err == nil {
+			t.Errorf("Preprocess(%s) = %q, want an error", test.name, src.Code)
+			continue
+		}
+		if err.Error() != test.want {
+			t.Errorf("Preprocess(%s): error %q, want %q", test.name, err, test.want)
+		}
+	}
+}
+
+func TestIncludeGuard(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{"#ifndef A\n#define A\nfloat a;\n#endif", true},
+		{"// header\n\n#ifndef A\n  #define A\nfloat a;\n#endif // A\n", true},
+		{"#ifndef A\n#define B\nfloat a;\n#endif", false},
+		{"#ifndef A\n#define A 1\nfloat a;\n#endif", false},
+		{"#ifndef A\n#define A\n#endif\nfloat a;", false},
+		{"float a;", false},
+	}
+	for _, test := range tests {
+		if got := includeGuard(strings.Split(test.code, "\n")); got != test.want {
+			t.Errorf("includeGuard(%q) = %v, want %v", test.code, got, test.want)
+		}
+	}
+}
+
+func TestSourceDiagnostics(t *testing.T) {
+	src, err := Preprocess(includeFS, "main.frag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := "3:4(24): error: `pi' undeclared\n" +
+		"1:3(7): warning: unused function\n" +
+		"0:4(1): error: syntax error\n" +
+		"7:1(1): error: unknown string\n" +
+		"error: linking failed\n"
+	want := []string{
+		"common.glsl:4:24: error: `pi' undeclared",
+		"lib/util.glsl:3:7: warning: unused function",
+		"main.frag:4:1: error: syntax error",
+		"7:1:1: error: unknown string",
+		"error: linking failed",
+	}
+	diagnostics := src.Diagnostics(log)
+	if len(diagnostics) != len(want) {
+		t.Fatalf("Diagnostics = %v, want %d", diagnostics, len(want))
+	}
+	for i, d := range diagnostics {
+		if d.String() != want[i] {
+			t.Errorf("Diagnostics[%d] = %q, want %q", i, d, want[i])
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import "unsafe"
+
+// Indirect Draw Commands
+
+// Mirrors DrawArraysIndirectCommand, as read by DrawArraysIndirect and
+// MultiDrawArraysIndirect
+type DrawArraysIndirectCommand struct {
+	Count         uint32
+	InstanceCount uint32
+	First         uint32
+	BaseInstance  uint32
+}
+
+// Mirrors DrawElementsIndirectCommand, as read by DrawElementsIndirect and
+// MultiDrawElementsIndirect
+type DrawElementsIndirectCommand struct {
+	Count         uint32
+	InstanceCount uint32
+	FirstIndex    uint32
+	BaseVertex    int32
+	BaseInstance  uint32
+}
+
+const (
+	drawArraysIndirectCommandSize   = int(unsafe.Sizeof(DrawArraysIndirectCommand{}))
+	drawElementsIndirectCommandSize = int(unsafe.Sizeof(DrawElementsIndirectCommand{}))
+)
+
+// IndirectCommands collects draw commands, writes them into a buffer of its
+// own and draws them all with one MultiDrawArraysIndirect and one
+// MultiDrawElementsIndirect call.
+type IndirectCommands struct {
+	Arrays   []DrawArraysIndirectCommand
+	Elements []DrawElementsIndirectCommand
+
+	buffer   Buffer
+	capacity int // size of buffer's data store
+	arrays   int // number of Arrays commands uploaded
+	elements int // number of Elements commands uploaded
+}
+
+// Create an empty command list with its buffer
+func NewIndirectCommands() *IndirectCommands {
+	return &IndirectCommands{buffer: CreateBuffer()}
+}
+
+// Returns the DRAW_INDIRECT_BUFFER the commands are written to. Arrays
+// commands come first, followed by Elements commands.
+func (commands *IndirectCommands) Buffer() Buffer {
+	return commands.buffer
+}
+
+// Queue a DrawArraysIndirectCommand, taking its fields in order
+func (commands *IndirectCommands) AddArrays(count, instanceCount, first, baseInstance int) {
+	commands.Arrays = append(commands.Arrays, DrawArraysIndirectCommand{
+		Count:         uint32(count),
+		InstanceCount: uint32(instanceCount),
+		First:         uint32(first),
+		BaseInstance:  uint32(baseInstance),
+	})
+}
+
+// Queue a DrawElementsIndirectCommand, taking its fields in order. Indices
+// are read from the ELEMENT_ARRAY_BUFFER bound when drawing.
+func (commands *IndirectCommands) AddElements(count, instanceCount, firstIndex, baseVertex, baseInstance int) {
+	commands.Elements = append(commands.Elements, DrawElementsIndirectCommand{
+		Count:         uint32(count),
+		InstanceCount: uint32(instanceCount),
+		FirstIndex:    uint32(firstIndex),
+		BaseVertex:    int32(baseVertex),
+		BaseInstance:  uint32(baseInstance),
+	})
+}
+
+// Remove all commands, keeping the buffer for reuse
+func (commands *IndirectCommands) Reset() {
+	commands.Arrays = commands.Arrays[:0]
+	commands.Elements = commands.Elements[:0]
+}
+
+// Write the commands into the buffer, growing it as needed. No buffer
+// binding is changed.
+func (commands *IndirectCommands) Upload() {
+	arrays := len(commands.Arrays) * drawArraysIndirectCommandSize
+	elements := len(commands.Elements) * drawElementsIndirectCommandSize
+
+	if arrays+elements > commands.capacity {
+		commands.capacity = arrays + elements
+		commands.buffer.Data(commands.capacity, nil, DYNAMIC_DRAW)
+	}
+	if arrays > 0 {
+		commands.buffer.SubData(0, arrays, commands.Arrays)
+	}
+	if elements > 0 {
+		commands.buffer.SubData(arrays, elements, commands.Elements)
+	}
+	commands.arrays = len(commands.Arrays)
+	commands.elements = len(commands.Elements)
+}
+
+// Draw the commands last uploaded with primitive type mode. typ is the type
+// of the indices read by Elements commands. The buffer is bound to
+// DRAW_INDIRECT_BUFFER for the draws, and the previous binding restored.
+func (commands *IndirectCommands) Draw(mode GLenum, typ GLenum) {
+	var previous [1]int32
+	GetIntegerv(DRAW_INDIRECT_BUFFER_BINDING, previous[:])
+	defer Buffer(previous[0]).Bind(DRAW_INDIRECT_BUFFER)
+
+	commands.buffer.Bind(DRAW_INDIRECT_BUFFER)
+	if commands.arrays > 0 {
+		MultiDrawArraysIndirect(mode, uintptr(0), commands.arrays, drawArraysIndirectCommandSize)
+	}
+	if commands.elements > 0 {
+		offset := commands.arrays * drawArraysIndirectCommandSize
+		MultiDrawElementsIndirect(mode, typ, uintptr(offset), commands.elements, drawElementsIndirectCommandSize)
+	}
+}
+
+// Delete the buffer
+func (commands *IndirectCommands) Delete() {
+	commands.buffer.Delete()
+	commands.buffer = 0
+	commands.capacity = 0
+	commands.arrays = 0
+	commands.elements = 0
+}
@@ -0,0 +1,570 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Block layouts
+//
+// Go values are laid out in uniform and shader storage blocks according to
+// their type:
+//
+//	float32, float64, int32, uint32, bool   float, double, int, uint, bool
+//	[N]T, N in 2..4, T one of the above     vecN, dvecN, ivecN, uvecN, bvecN
+//	[C][R]float32, [C][R]float64            matCxR, dmatCxR (C columns of R rows)
+//	[N]T of anything else                   T[N]
+//	[]T                                     T[], std430 only
+//	struct                                  struct
+//
+// A struct field is named after its gl tag, or after the field itself when it
+// has none. Unexported fields and fields tagged "-" are skipped, padding is
+// inserted as the layout requires. The tag option "array" makes a field's
+// outermost [N]T an array even where it would be a vector or a matrix, so a
+// [4]float32 tagged `gl:"weights,array"` is float weights[4] and not a vec4.
+//
+// Slices are runtime-sized arrays. They can only be the last field of a block,
+// or the whole block, as the last member of a shader storage block is the only
+// place GLSL allows them.
+
+type blockLayout int
+
+const (
+	std140 blockLayout = iota
+	std430
+)
+
+func (layout blockLayout) String() string {
+	if layout == std430 {
+		return "std430"
+	}
+	return "std140"
+}
+
+type layoutKind int
+
+const (
+	layoutScalar layoutKind = iota
+	layoutVector
+	layoutMatrix
+	layoutArray
+	layoutStruct
+)
+
+// The shape of a Go type laid out in a block
+type layoutType struct {
+	kind   layoutKind
+	scalar reflect.Kind // base type of scalars, vectors and matrices
+	align  int
+	size   int
+	len    int         // number of components, columns or elements
+	stride int         // distance between components, columns or elements
+	elem   *layoutType // type of components, columns or elements
+	fields []layoutField
+
+	// Runtime-sized array, holding as many elements as the Go slice. Its
+	// size and that of the struct ending with it do not count them.
+	runtime bool
+}
+
+type layoutField struct {
+	name   string
+	index  int
+	offset int
+	typ    *layoutType
+}
+
+func roundUp(n, align int) int {
+	return (n + align - 1) / align * align
+}
+
+// Returns the layout of v, a struct or a pointer to one, and the struct
+// itself. std430 also lays out arrays and slices, or pointers to them.
+func (layout blockLayout) layoutOf(v interface{}) (*layoutType, reflect.Value, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	var t *layoutType
+	var err error
+	switch {
+	case rv.Kind() == reflect.Struct:
+		t, err = layout.structOf(rv.Type(), true)
+	case layout == std430 && rv.Kind() == reflect.Array:
+		t, err = layout.typeOf(rv.Type(), true)
+	case layout == std430 && rv.Kind() == reflect.Slice:
+		t, err = layout.runtimeArray(rv.Type())
+	default:
+		return nil, rv, fmt.Errorf("gl: %T has no %s layout", v, layout)
+	}
+	if err != nil {
+		return nil, rv, fmt.Errorf("gl: %s: %v", layout, err)
+	}
+	return t, rv, nil
+}
+
+func (layout blockLayout) typeOf(t reflect.Type, array bool) (*layoutType, error) {
+	switch t.Kind() {
+	case reflect.Float32, reflect.Int32, reflect.Uint32, reflect.Bool:
+		return &layoutType{kind: layoutScalar, scalar: t.Kind(), align: 4, size: 4}, nil
+	case reflect.Float64:
+		return &layoutType{kind: layoutScalar, scalar: t.Kind(), align: 8, size: 8}, nil
+	case reflect.Array:
+		elem, err := layout.typeOf(t.Elem(), false)
+		if err != nil {
+			return nil, err
+		}
+		n := t.Len()
+		if !array && n >= 2 && n <= 4 {
+			if elem.kind == layoutScalar {
+				return layout.vector(elem, n), nil
+			}
+			if elem.kind == layoutVector && (elem.scalar == reflect.Float32 || elem.scalar == reflect.Float64) {
+				return layout.array(layoutMatrix, elem, n), nil
+			}
+		}
+		return layout.array(layoutArray, elem, n), nil
+	case reflect.Struct:
+		return layout.structOf(t, false)
+	}
+	return nil, fmt.Errorf("%s has no GLSL equivalent", t)
+}
+
+func (layout blockLayout) vector(scalar *layoutType, n int) *layoutType {
+	align := 4 * scalar.size
+	if n == 2 {
+		align = 2 * scalar.size
+	}
+	return &layoutType{
+		kind:   layoutVector,
+		scalar: scalar.scalar,
+		align:  align,
+		size:   n * scalar.size,
+		len:    n,
+		stride: scalar.size,
+		elem:   scalar,
+	}
+}
+
+// Lays out matrices as arrays of column vectors
+func (layout blockLayout) array(kind layoutKind, elem *layoutType, n int) *layoutType {
+	align := elem.align
+	if layout == std140 {
+		align = roundUp(align, 16)
+	}
+	stride := roundUp(elem.size, align)
+	return &layoutType{
+		kind:   kind,
+		scalar: elem.scalar,
+		align:  align,
+		size:   n * stride,
+		len:    n,
+		stride: stride,
+		elem:   elem,
+	}
+}
+
+func (layout blockLayout) runtimeArray(t reflect.Type) (*layoutType, error) {
+	elem, err := layout.typeOf(t.Elem(), false)
+	if err != nil {
+		return nil, err
+	}
+	// The length of the array is only known from its stride
+	if elem.size == 0 {
+		return nil, fmt.Errorf("%s has no GLSL equivalent", t)
+	}
+	a := layout.array(layoutArray, elem, 0)
+	a.runtime = true
+	return a, nil
+}
+
+// Lays out the struct t, which is a whole block if top is set
+func (layout blockLayout) structOf(t reflect.Type, top bool) (*layoutType, error) {
+	st := &layoutType{kind: layoutStruct, align: 1}
+	offset := 0
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, opts, skip := parseGLTag(f)
+		if skip {
+			continue
+		}
+		if n := len(st.fields); n > 0 && st.fields[n-1].typ.runtime {
+			return nil, fmt.Errorf("%v.%s: field follows a slice", t, f.Name)
+		}
+		var ft *layoutType
+		var err error
+		if f.Type.Kind() == reflect.Slice && top && layout == std430 {
+			ft, err = layout.runtimeArray(f.Type)
+		} else {
+			ft, err = layout.typeOf(f.Type, hasOption(opts, "array"))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%v.%s: %v", t, f.Name, err)
+		}
+		offset = roundUp(offset, ft.align)
+		st.fields = append(st.fields, layoutField{name, i, offset, ft})
+		offset += ft.size
+		if ft.align > st.align {
+			st.align = ft.align
+		}
+	}
+	if layout == std140 {
+		st.align = roundUp(st.align, 16)
+	}
+	st.size = roundUp(offset, st.align)
+	if n := len(st.fields); n > 0 && st.fields[n-1].typ.runtime {
+		st.size = st.fields[n-1].offset
+	}
+	return st, nil
+}
+
+// Returns the name and options of the gl tag of f, naming the field after
+// itself if the tag does not. Unexported fields and fields tagged "-" are
+// skipped.
+func parseGLTag(f reflect.StructField) (name string, opts []string, skip bool) {
+	if f.PkgPath != "" {
+		return "", nil, true
+	}
+	tag := f.Tag.Get("gl")
+	if tag == "" {
+		return f.Name, nil, false
+	}
+	opts = strings.Split(tag, ",")
+	name, opts = opts[0], opts[1:]
+	if name == "-" {
+		return "", nil, true
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return name, opts, false
+}
+
+func hasOption(opts []string, option string) bool {
+	for _, opt := range opts {
+		if opt == option {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the size of v, including the elements of runtime-sized arrays
+func (t *layoutType) sizeOf(v reflect.Value) int {
+	if t.runtime {
+		return v.Len() * t.stride
+	}
+	if n := len(t.fields); n > 0 && t.fields[n-1].typ.runtime {
+		f := t.fields[n-1]
+		return f.offset + f.typ.sizeOf(v.Field(f.index))
+	}
+	return t.size
+}
+
+// Writes v into b, which starts at v's offset
+func (t *layoutType) encode(b []byte, v reflect.Value) {
+	switch t.kind {
+	case layoutScalar:
+		switch t.scalar {
+		case reflect.Float32:
+			binary.LittleEndian.PutUint32(b, math.Float32bits(float32(v.Float())))
+		case reflect.Float64:
+			binary.LittleEndian.PutUint64(b, math.Float64bits(v.Float()))
+		case reflect.Int32:
+			binary.LittleEndian.PutUint32(b, uint32(v.Int()))
+		case reflect.Uint32:
+			binary.LittleEndian.PutUint32(b, uint32(v.Uint()))
+		case reflect.Bool:
+			var x uint32
+			if v.Bool() {
+				x = 1
+			}
+			binary.LittleEndian.PutUint32(b, x)
+		}
+	case layoutStruct:
+		for _, f := range t.fields {
+			f.typ.encode(b[f.offset:], v.Field(f.index))
+		}
+	default:
+		n := t.len
+		if t.runtime {
+			n = v.Len()
+		}
+		for i := 0; i < n; i++ {
+			t.elem.encode(b[i*t.stride:], v.Index(i))
+		}
+	}
+}
+
+// Reads v from b, which starts at v's offset. Runtime-sized arrays get as
+// many elements as fit in b.
+func (t *layoutType) decode(b []byte, v reflect.Value) {
+	switch t.kind {
+	case layoutScalar:
+		switch t.scalar {
+		case reflect.Float32:
+			v.SetFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(b))))
+		case reflect.Float64:
+			v.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(b)))
+		case reflect.Int32:
+			v.SetInt(int64(int32(binary.LittleEndian.Uint32(b))))
+		case reflect.Uint32:
+			v.SetUint(uint64(binary.LittleEndian.Uint32(b)))
+		case reflect.Bool:
+			v.SetBool(binary.LittleEndian.Uint32(b) != 0)
+		}
+	case layoutStruct:
+		for _, f := range t.fields {
+			f.typ.decode(b[f.offset:], v.Field(f.index))
+		}
+	default:
+		n := t.len
+		if t.runtime {
+			n = len(b) / t.stride
+			if v.Cap() >= n {
+				v.Set(v.Slice(0, n))
+			} else {
+				v.Set(reflect.MakeSlice(v.Type(), n, n))
+			}
+		}
+		for i := 0; i < n; i++ {
+			t.elem.decode(b[i*t.stride:], v.Index(i))
+		}
+	}
+}
+
+// A member of basic type as the GL enumerates it: arrays of basic types are
+// a single member named after their first element.
+type layoutMember struct {
+	name        string
+	offset      int
+	typ         *layoutType // the member's type, or its element type for arrays
+	size        int         // number of array elements, 1 for non-arrays
+	arrayStride int
+	topStride   int // stride of the top-level array holding the member
+}
+
+func (t *layoutType) members(name string, offset int, list []layoutMember) []layoutMember {
+	switch t.kind {
+	case layoutStruct:
+		for _, f := range t.fields {
+			fname := f.name
+			if name != "" {
+				fname = name + "." + f.name
+			}
+			list = f.typ.members(fname, offset+f.offset, list)
+		}
+	case layoutArray:
+		if t.elem.kind == layoutStruct || t.elem.kind == layoutArray {
+			for i := 0; i < t.len; i++ {
+				list = t.elem.members(fmt.Sprintf("%s[%d]", name, i), offset+i*t.stride, list)
+			}
+		} else {
+			list = append(list, layoutMember{name + "[0]", offset, t.elem, t.len, t.stride, 0})
+		}
+	default:
+		list = append(list, layoutMember{name, offset, t, 1, 0, 0})
+	}
+	return list
+}
+
+// Lists the members of the block t as buffer variables, which unlike
+// uniforms only enumerate the first element of top-level arrays.
+func (t *layoutType) bufferMembers() []layoutMember {
+	var list []layoutMember
+	for _, f := range t.fields {
+		start := len(list)
+		if f.typ.kind == layoutArray && (f.typ.elem.kind == layoutStruct || f.typ.elem.kind == layoutArray) {
+			list = f.typ.elem.members(f.name+"[0]", f.offset, list)
+		} else {
+			list = f.typ.members(f.name, f.offset, list)
+		}
+		if f.typ.kind == layoutArray {
+			for i := start; i < len(list); i++ {
+				list[i].topStride = f.typ.stride
+			}
+		}
+	}
+	return list
+}
+
+// A member of a block as the GL reports it
+type activeMember struct {
+	name         string
+	typ          GLenum
+	size         int
+	offset       int
+	arrayStride  int
+	matrixStride int
+	topStride    int // 0 for members of uniform blocks
+}
+
+// Compares m with a, the member of the block that v is laid out for
+func (m layoutMember) check(block string, v interface{}, a activeMember) error {
+	mMatrixStride := 0
+	if m.typ.kind == layoutMatrix {
+		mMatrixStride = m.typ.stride
+	}
+	switch {
+	case a.typ != m.typ.glType():
+		return fmt.Errorf("gl: %s.%s has type 0x%x, %T has 0x%x", block, m.name, a.typ, v, m.typ.glType())
+	case a.size != m.size:
+		return fmt.Errorf("gl: %s.%s has %d elements, %T has %d", block, m.name, a.size, v, m.size)
+	case a.offset != m.offset:
+		return fmt.Errorf("gl: %s.%s is at offset %d, %T puts it at %d", block, m.name, a.offset, v, m.offset)
+	case a.arrayStride != m.arrayStride:
+		return fmt.Errorf("gl: %s.%s has array stride %d, %T has %d", block, m.name, a.arrayStride, v, m.arrayStride)
+	case a.matrixStride != mMatrixStride:
+		return fmt.Errorf("gl: %s.%s has matrix stride %d, %T has %d", block, m.name, a.matrixStride, v, mMatrixStride)
+	case a.topStride != m.topStride:
+		return fmt.Errorf("gl: %s.%s has top level array stride %d, %T has %d", block, m.name, a.topStride, v, m.topStride)
+	}
+	return nil
+}
+
+// Matches members, laid out for v, with the n active members of block, of
+// which member returns the i-th, and checks every pair. kind names the kind
+// of block in errors.
+func checkBlockMembers(kind, block string, v interface{}, members []layoutMember, n int, member func(i int) activeMember) error {
+	// Members of blocks with an instance name are prefixed by the block name
+	prefix := strings.SplitN(block, "[", 2)[0] + "."
+
+	list := make([]activeMember, n)
+	active := make(map[string]int, n)
+	for i := range list {
+		list[i] = member(i)
+		list[i].name = strings.TrimPrefix(list[i].name, prefix)
+		active[list[i].name] = i
+	}
+
+	for _, m := range members {
+		i, ok := active[m.name]
+		if !ok {
+			return fmt.Errorf("gl: %s %s has no member %s", kind, block, m.name)
+		}
+		delete(active, m.name)
+		if err := m.check(block, v, list[i]); err != nil {
+			return err
+		}
+	}
+	for _, a := range list {
+		if _, ok := active[a.name]; ok {
+			return fmt.Errorf("gl: %T has no field for %s.%s", v, block, a.name)
+		}
+	}
+	return nil
+}
+
+var vectorTypes = map[reflect.Kind][4]GLenum{
+	reflect.Float32: {FLOAT, FLOAT_VEC2, FLOAT_VEC3, FLOAT_VEC4},
+	reflect.Float64: {DOUBLE, DOUBLE_VEC2, DOUBLE_VEC3, DOUBLE_VEC4},
+	reflect.Int32:   {INT, INT_VEC2, INT_VEC3, INT_VEC4},
+	reflect.Uint32:  {UNSIGNED_INT, UNSIGNED_INT_VEC2, UNSIGNED_INT_VEC3, UNSIGNED_INT_VEC4},
+	reflect.Bool:    {BOOL, BOOL_VEC2, BOOL_VEC3, BOOL_VEC4},
+}
+
+// Indexed by columns-2 and rows-2
+var matrixTypes = map[reflect.Kind][3][3]GLenum{
+	reflect.Float32: {
+		{FLOAT_MAT2, FLOAT_MAT2x3, FLOAT_MAT2x4},
+		{FLOAT_MAT3x2, FLOAT_MAT3, FLOAT_MAT3x4},
+		{FLOAT_MAT4x2, FLOAT_MAT4x3, FLOAT_MAT4},
+	},
+	reflect.Float64: {
+		{DOUBLE_MAT2, DOUBLE_MAT2x3, DOUBLE_MAT2x4},
+		{DOUBLE_MAT3x2, DOUBLE_MAT3, DOUBLE_MAT3x4},
+		{DOUBLE_MAT4x2, DOUBLE_MAT4x3, DOUBLE_MAT4},
+	},
+}
+
+// Returns the type enum the GL reports for a scalar, vector or matrix
+func (t *layoutType) glType() GLenum {
+	switch t.kind {
+	case layoutScalar:
+		return vectorTypes[t.scalar][0]
+	case layoutVector:
+		return vectorTypes[t.scalar][t.len-1]
+	case layoutMatrix:
+		return matrixTypes[t.scalar][t.len-2][t.elem.len-2]
+	}
+	return 0
+}
+
+// Returns the component kind, columns and rows of a type the GL reports for
+// uniforms, inputs and varyings: 1 column for scalars and vectors, and a
+// single int for samplers and images. Kind is Invalid for other types.
+func glTypeShape(typ GLenum) (kind reflect.Kind, columns, rows int) {
+	for kind, types := range vectorTypes {
+		for n, t := range types {
+			if t == typ {
+				return kind, 1, n + 1
+			}
+		}
+	}
+	for kind, types := range matrixTypes {
+		for c := range types {
+			for r, t := range types[c] {
+				if t == typ {
+					return kind, c + 2, r + 2
+				}
+			}
+		}
+	}
+	if _, ok := glslTypeNames[typ]; ok && typ != UNSIGNED_INT_ATOMIC_COUNTER {
+		return reflect.Int32, 1, 1
+	}
+	return reflect.Invalid, 0, 0
+}
+
+// Returns the bytes a component of kind takes in GL memory
+func componentSize(kind reflect.Kind) int {
+	if kind == reflect.Float64 {
+		return 8
+	}
+	return 4
+}
+
+// EncodeStd140 returns v, a struct or a pointer to one, laid out as a std140
+// uniform block.
+func EncodeStd140(v interface{}) ([]byte, error) {
+	t, rv, err := std140.layoutOf(v)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, t.size)
+	t.encode(b, rv)
+	return b, nil
+}
+
+// EncodeStd430 returns v laid out as std430, the layout of shader storage
+// blocks. v is a struct, an array or a slice, or a pointer to one.
+func EncodeStd430(v interface{}) ([]byte, error) {
+	t, rv, err := std430.layoutOf(v)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, t.sizeOf(rv))
+	t.encode(b, rv)
+	return b, nil
+}
+
+// DecodeStd430 reads b, laid out as std430, into v, a pointer to a struct, an
+// array or a slice. Runtime-sized arrays are resized to the elements b holds.
+func DecodeStd430(b []byte, v interface{}) error {
+	if rv := reflect.ValueOf(v); rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("gl: %T is not a pointer", v)
+	}
+	t, rv, err := std430.layoutOf(v)
+	if err != nil {
+		return err
+	}
+	if len(b) < t.size {
+		return fmt.Errorf("gl: %d bytes are too short for %T, need %d", len(b), v, t.size)
+	}
+	t.decode(b, rv)
+	return nil
+}
@@ -0,0 +1,347 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type layoutLight struct {
+	Color [3]float32
+	On    bool
+	Range [2]float32
+}
+
+type layoutLights struct {
+	Count  int32
+	Lights [2]layoutLight
+}
+
+type layoutParticles struct {
+	Count     uint32
+	Positions [][3]float32
+}
+
+// Builds the expected bytes of a block from the values at their offsets
+type layoutBytes map[int]interface{}
+
+func (values layoutBytes) bytes(size int) []byte {
+	b := make([]byte, size)
+	for offset, v := range values {
+		switch v := v.(type) {
+		case float32:
+			binary.LittleEndian.PutUint32(b[offset:], math.Float32bits(v))
+		case float64:
+			binary.LittleEndian.PutUint64(b[offset:], math.Float64bits(v))
+		case int32:
+			binary.LittleEndian.PutUint32(b[offset:], uint32(v))
+		case uint32:
+			binary.LittleEndian.PutUint32(b[offset:], v)
+		default:
+			panic(v)
+		}
+	}
+	return b
+}
+
+var layoutTests = []struct {
+	name   string
+	v      interface{}
+	std140 layoutBytes // nil if v has no std140 layout
+	size   int
+	std430 layoutBytes
+	size2  int
+}{
+	{
+		// vec3 is aligned like vec4 but a scalar fits in its padding
+		name: "vec3 padding",
+		v: &struct {
+			A [3]float32
+			B float32
+			C [3]float32
+		}{[3]float32{1, 2, 3}, 4, [3]float32{5, 6, 7}},
+		std140: layoutBytes{0: float32(1), 4: float32(2), 8: float32(3), 12: float32(4), 16: float32(5), 20: float32(6), 24: float32(7)},
+		size:   32,
+		std430: layoutBytes{0: float32(1), 4: float32(2), 8: float32(3), 12: float32(4), 16: float32(5), 20: float32(6), 24: float32(7)},
+		size2:  32,
+	},
+	{
+		// vec2 and dvec3 alignment
+		name: "vec2 and dvec3",
+		v: &struct {
+			A float32
+			B [2]float32
+			C [3]float64
+		}{1, [2]float32{2, 3}, [3]float64{4, 5, 6}},
+		std140: layoutBytes{0: float32(1), 8: float32(2), 12: float32(3), 32: float64(4), 40: float64(5), 48: float64(6)},
+		size:   64,
+		std430: layoutBytes{0: float32(1), 8: float32(2), 12: float32(3), 32: float64(4), 40: float64(5), 48: float64(6)},
+		size2:  64,
+	},
+	{
+		// Columns of mat3 are vec3, which have a stride of 16 in both
+		name: "mat3 columns",
+		v: &struct {
+			M [3][3]float32
+		}{[3][3]float32{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}},
+		std140: layoutBytes{
+			0: float32(1), 4: float32(2), 8: float32(3),
+			16: float32(4), 20: float32(5), 24: float32(6),
+			32: float32(7), 36: float32(8), 40: float32(9),
+		},
+		size: 48,
+		std430: layoutBytes{
+			0: float32(1), 4: float32(2), 8: float32(3),
+			16: float32(4), 20: float32(5), 24: float32(6),
+			32: float32(7), 36: float32(8), 40: float32(9),
+		},
+		size2: 48,
+	},
+	{
+		// mat2 columns are vec2: padded to 16 in std140 only
+		name: "mat2 columns",
+		v: &struct {
+			M [2][2]float32
+		}{[2][2]float32{{1, 2}, {3, 4}}},
+		std140: layoutBytes{0: float32(1), 4: float32(2), 16: float32(3), 20: float32(4)},
+		size:   32,
+		std430: layoutBytes{0: float32(1), 4: float32(2), 8: float32(3), 12: float32(4)},
+		size2:  16,
+	},
+	{
+		// Scalar array elements are padded to 16 bytes in std140 only
+		name: "float array",
+		v: &struct {
+			W [3]float32 `gl:"w,array"`
+			X float32
+		}{[3]float32{1, 2, 3}, 4},
+		std140: layoutBytes{0: float32(1), 16: float32(2), 32: float32(3), 48: float32(4)},
+		size:   64,
+		std430: layoutBytes{0: float32(1), 4: float32(2), 8: float32(3), 12: float32(4)},
+		size2:  16,
+	},
+	{
+		// Arrays of more than 4 elements are never vectors
+		name: "int array",
+		v: &struct {
+			I [5]int32
+		}{[5]int32{1, 2, 3, 4, 5}},
+		std140: layoutBytes{0: int32(1), 16: int32(2), 32: int32(3), 48: int32(4), 64: int32(5)},
+		size:   80,
+		std430: layoutBytes{0: int32(1), 4: int32(2), 8: int32(3), 12: int32(4), 16: int32(5)},
+		size2:  20,
+	},
+	{
+		// Structs align to their largest member, vec3 here, and are
+		// padded to it; vec2 Range starts after the bool at 12
+		name: "nested struct array",
+		v: &layoutLights{3, [2]layoutLight{
+			{[3]float32{1, 2, 3}, true, [2]float32{4, 5}},
+			{[3]float32{6, 7, 8}, false, [2]float32{9, 10}},
+		}},
+		std140: layoutBytes{
+			0:  int32(3),
+			16: float32(1), 20: float32(2), 24: float32(3), 28: uint32(1), 32: float32(4), 36: float32(5),
+			48: float32(6), 52: float32(7), 56: float32(8), 60: uint32(0), 64: float32(9), 68: float32(10),
+		},
+		size: 80,
+		std430: layoutBytes{
+			0:  int32(3),
+			16: float32(1), 20: float32(2), 24: float32(3), 28: uint32(1), 32: float32(4), 36: float32(5),
+			48: float32(6), 52: float32(7), 56: float32(8), 60: uint32(0), 64: float32(9), 68: float32(10),
+		},
+		size2: 80,
+	},
+	{
+		// A trailing runtime array holds as many elements as the slice
+		name: "runtime array",
+		v:    &layoutParticles{2, [][3]float32{{1, 2, 3}, {4, 5, 6}}},
+		std430: layoutBytes{
+			0:  uint32(2),
+			16: float32(1), 20: float32(2), 24: float32(3),
+			32: float32(4), 36: float32(5), 40: float32(6),
+		},
+		size2: 48,
+	},
+	{
+		name:   "top level slice",
+		v:      []float32{1, 2, 3},
+		std430: layoutBytes{0: float32(1), 4: float32(2), 8: float32(3)},
+		size2:  12,
+	},
+}
+
+func TestEncodeStd140(t *testing.T) {
+	for _, test := range layoutTests {
+		b, err := EncodeStd140(test.v)
+		if test.std140 == nil {
+			if err == nil {
+				t.Errorf("%s: EncodeStd140 succeeded, want an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: EncodeStd140: %v", test.name, err)
+			continue
+		}
+		if want := test.std140.bytes(test.size); !reflect.DeepEqual(b, want) {
+			t.Errorf("%s: EncodeStd140 =\n%v\nwant\n%v", test.name, b, want)
+		}
+	}
+}
+
+func TestEncodeStd430(t *testing.T) {
+	for _, test := range layoutTests {
+		b, err := EncodeStd430(test.v)
+		if err != nil {
+			t.Errorf("%s: EncodeStd430: %v", test.name, err)
+			continue
+		}
+		if want := test.std430.bytes(test.size2); !reflect.DeepEqual(b, want) {
+			t.Errorf("%s: EncodeStd430 =\n%v\nwant\n%v", test.name, b, want)
+		}
+	}
+}
+
+func TestDecodeStd430(t *testing.T) {
+	for _, test := range layoutTests {
+		// Decoding into a zero value of the same type gives the value back
+		v := reflect.New(reflect.Indirect(reflect.ValueOf(test.v)).Type())
+		if err := DecodeStd430(test.std430.bytes(test.size2), v.Interface()); err != nil {
+			t.Errorf("%s: DecodeStd430: %v", test.name, err)
+			continue
+		}
+		if want := reflect.Indirect(reflect.ValueOf(test.v)).Interface(); !reflect.DeepEqual(v.Elem().Interface(), want) {
+			t.Errorf("%s: DecodeStd430 = %+v, want %+v", test.name, v.Elem().Interface(), want)
+		}
+	}
+}
+
+func TestDecodeStd430RuntimeArray(t *testing.T) {
+	// Slices are resized to the elements the buffer holds, reusing their
+	// storage when it is large enough
+	b := layoutBytes{0: uint32(3), 16: float32(1), 32: float32(2), 48: float32(3)}.bytes(64)
+	v := layoutParticles{Positions: make([][3]float32, 1, 8)}
+	if err := DecodeStd430(b, &v); err != nil {
+		t.Fatal(err)
+	}
+	want := layoutParticles{3, [][3]float32{{1, 0, 0}, {2, 0, 0}, {3, 0, 0}}}
+	if !reflect.DeepEqual(v, want) {
+		t.Errorf("DecodeStd430 = %+v, want %+v", v, want)
+	}
+	if cap(v.Positions) != 8 {
+		t.Errorf("DecodeStd430 reallocated the slice, cap %d", cap(v.Positions))
+	}
+
+	// A buffer with no room for elements empties the slice
+	if err := DecodeStd430(b[:16], &v); err != nil {
+		t.Fatal(err)
+	}
+	if len(v.Positions) != 0 {
+		t.Errorf("DecodeStd430 left %d elements", len(v.Positions))
+	}
+}
+
+func TestLayoutErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  func() error
+		want string
+	}{
+		{
+			"field after a slice",
+			func() error {
+				_, err := EncodeStd430(&struct {
+					V []float32
+					N int32
+				}{})
+				return err
+			},
+			"N: field follows a slice",
+		},
+		{
+			"int field",
+			func() error {
+				_, err := EncodeStd140(&struct{ N int }{})
+				return err
+			},
+			"N: int has no GLSL equivalent",
+		},
+		{
+			"int field in a nested struct",
+			func() error {
+				_, err := EncodeStd430(&struct{ L struct{ N int } }{})
+				return err
+			},
+			"N: int has no GLSL equivalent",
+		},
+		{
+			"slice in std140",
+			func() error {
+				_, err := EncodeStd140(&layoutParticles{})
+				return err
+			},
+			"Positions: [][3]float32 has no GLSL equivalent",
+		},
+		{
+			"slice in a nested struct",
+			func() error {
+				_, err := EncodeStd430(&struct{ L struct{ V []float32 } }{})
+				return err
+			},
+			"V: []float32 has no GLSL equivalent",
+		},
+		{
+			"slice of empty structs",
+			func() error {
+				return DecodeStd430(make([]byte, 16), &struct{ V []struct{} }{})
+			},
+			"V: []struct {} has no GLSL equivalent",
+		},
+		{
+			"not a struct",
+			func() error {
+				_, err := EncodeStd140([]float32{1})
+				return err
+			},
+			"[]float32 has no std140 layout",
+		},
+		{
+			"too short buffer",
+			func() error {
+				return DecodeStd430(make([]byte, 79), &layoutLights{})
+			},
+			"79 bytes are too short for *gl.layoutLights, need 80",
+		},
+		{
+			"too short for the fixed part",
+			func() error {
+				return DecodeStd430(make([]byte, 8), &layoutParticles{})
+			},
+			"8 bytes are too short for *gl.layoutParticles, need 16",
+		},
+		{
+			"not a pointer",
+			func() error {
+				return DecodeStd430(make([]byte, 80), layoutLights{})
+			},
+			"gl.layoutLights is not a pointer",
+		},
+	}
+	for _, test := range tests {
+		err := test.err()
+		if err == nil {
+			t.Errorf("%s: no error", test.name)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), "gl: ") || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: error %q, want %q", test.name, err, test.want)
+		}
+	}
+}
@@ -14,7 +14,10 @@ type Program Object
 
 func CreateProgram() Program { return Program(C.glCreateProgram()) }
 
-func (program Program) Delete() { C.glDeleteProgram(C.GLuint(program)) }
+func (program Program) Delete() {
+	forgetUniformPlans(program)
+	C.glDeleteProgram(C.GLuint(program))
+}
 
 func (program Program) AttachShader(shader Shader) {
 	C.glAttachShader(C.GLuint(program), C.GLuint(shader))
@@ -22,10 +25,12 @@ func (program Program) AttachShader(shader Shader) {
 
 func (program Program) GetAttachedShaders() []Object {
 	var len C.GLint
-	C.glGetProgramiv(C.GLuint(program), C.GLenum(ACTIVE_UNIFORM_MAX_LENGTH), &len)
+	C.glGetProgramiv(C.GLuint(program), C.GLenum(ATTACHED_SHADERS), &len)
 
 	objects := make([]Object, len)
-	C.glGetAttachedShaders(C.GLuint(program), C.GLsizei(len), nil, *((**C.GLuint)(unsafe.Pointer(&objects))))
+	if len > 0 {
+		C.glGetAttachedShaders(C.GLuint(program), C.GLsizei(len), nil, (*C.GLuint)(unsafe.Pointer(&objects[0])))
+	}
 	return objects
 }
 
@@ -51,7 +56,34 @@ func (program Program) TransformFeedbackVaryings(names []string, buffer_mode GLe
 	}
 }
 
-func (program Program) Link() { C.glLinkProgram(C.GLuint(program)) }
+// glGetTransformFeedbackVarying(GLuint program, GLuint index, GLsizei bufSize, GLsizei *length, GLsizei *size, GLenum *type, GLchar *name)
+func (program Program) GetTransformFeedbackVarying(index int) (
+	Size int, Type GLenum, Name string) {
+	// Maximum length of transform feedback varying name in program
+	bufSize := program.Get(TRANSFORM_FEEDBACK_VARYING_MAX_LENGTH)
+	if bufSize < 1 {
+		return
+	}
+	nameBuf := C.malloc(C.size_t(bufSize))
+	defer C.free(nameBuf)
+	var size C.GLsizei
+	C.glGetTransformFeedbackVarying(
+		C.GLuint(program),
+		C.GLuint(index),
+		C.GLsizei(bufSize),
+		nil, // length == len(Name)
+		&size,
+		(*C.GLenum)(&Type),
+		(*C.GLchar)(nameBuf))
+	Name = C.GoString((*C.char)(nameBuf))
+	Size = int(size)
+	return
+}
+
+func (program Program) Link() {
+	forgetUniformPlans(program)
+	C.glLinkProgram(C.GLuint(program))
+}
 
 func (program Program) Validate() { C.glValidateProgram(C.GLuint(program)) }
 
@@ -102,6 +134,30 @@ func (program Program) GetActiveUniform(index int) (
 	return
 }
 
+// glGetActiveAttrib(GLuint program, GLuint index, GLsizei bufSize, GLsizei *length, GLint *size, GLenum *type, GLchar *name)
+func (program Program) GetActiveAttrib(index int) (
+	Size int, Type GLenum, Name string) {
+	// Maximum length of active attribute name in program
+	bufSize := program.Get(ACTIVE_ATTRIBUTE_MAX_LENGTH)
+	if bufSize < 1 {
+		return
+	}
+	nameBuf := C.malloc(C.size_t(bufSize))
+	defer C.free(nameBuf)
+	var size C.GLint
+	C.glGetActiveAttrib(
+		C.GLuint(program),
+		C.GLuint(index),
+		C.GLsizei(bufSize),
+		nil, // length == len(Name)
+		&size,
+		(*C.GLenum)(&Type),
+		(*C.GLchar)(nameBuf))
+	Name = C.GoString((*C.char)(nameBuf))
+	Size = int(size)
+	return
+}
+
 func (program Program) GetUniformiv(location UniformLocation, values []int32) {
 	if len(values) == 0 {
 		panic("Invalid values length")
@@ -120,6 +176,51 @@ func (program Program) GetUniformfv(location UniformLocation, values []float32)
 	C.glGetUniformfv(C.GLuint(program), C.GLint(location), (*C.GLfloat)(&(values[0])))
 }
 
+func (program Program) GetUniformuiv(location UniformLocation, values []uint32) {
+	if len(values) == 0 {
+		panic("Invalid values length")
+	}
+	C.glGetUniformuiv(C.GLuint(program), C.GLint(location), (*C.GLuint)(&(values[0])))
+}
+
+func (program Program) GetUniformdv(location UniformLocation, values []float64) {
+	if len(values) == 0 {
+		panic("Invalid values length")
+	}
+	C.glGetUniformdv(C.GLuint(program), C.GLint(location), (*C.GLdouble)(&(values[0])))
+}
+
+// The GetnUniform functions write at most len(values) components, raising
+// INVALID_OPERATION instead of overflowing the slice.
+
+func (program Program) GetnUniformiv(location UniformLocation, values []int32) {
+	if len(values) == 0 {
+		panic("Invalid values length")
+	}
+	C.glGetnUniformiv(C.GLuint(program), C.GLint(location), C.GLsizei(4*len(values)), (*C.GLint)(&(values[0])))
+}
+
+func (program Program) GetnUniformuiv(location UniformLocation, values []uint32) {
+	if len(values) == 0 {
+		panic("Invalid values length")
+	}
+	C.glGetnUniformuiv(C.GLuint(program), C.GLint(location), C.GLsizei(4*len(values)), (*C.GLuint)(&(values[0])))
+}
+
+func (program Program) GetnUniformfv(location UniformLocation, values []float32) {
+	if len(values) == 0 {
+		panic("Invalid values length")
+	}
+	C.glGetnUniformfv(C.GLuint(program), C.GLint(location), C.GLsizei(4*len(values)), (*C.GLfloat)(&(values[0])))
+}
+
+func (program Program) GetnUniformdv(location UniformLocation, values []float64) {
+	if len(values) == 0 {
+		panic("Invalid values length")
+	}
+	C.glGetnUniformdv(C.GLuint(program), C.GLint(location), C.GLsizei(8*len(values)), (*C.GLdouble)(&(values[0])))
+}
+
 func (program Program) GetUniformLocation(name string) UniformLocation {
 
 	cname := glString(name)
@@ -0,0 +1,275 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+// #include "gl.h"
+import "C"
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unsafe"
+)
+
+// Program Binaries
+
+// void glGetProgramBinary(GLuint program, GLsizei bufSize, GLsizei *length, GLenum *binaryFormat, void *binary);
+//
+// Returns the binary of a linked program, or nil if the driver provides
+// none. Set PROGRAM_BINARY_RETRIEVABLE_HINT with Parameteri before linking
+// to get one from every driver.
+func (program Program) GetBinary() (format GLenum, binary []byte) {
+	length := program.Get(PROGRAM_BINARY_LENGTH)
+	if length <= 0 {
+		return 0, nil
+	}
+	binary = make([]byte, length)
+	var written C.GLsizei
+	C.glGetProgramBinary(C.GLuint(program), C.GLsizei(length), &written, (*C.GLenum)(&format), unsafe.Pointer(&binary[0]))
+	return format, binary[:written]
+}
+
+// void glProgramBinary(GLuint program, GLenum binaryFormat, const void *binary, GLsizei length);
+//
+// Loads a binary returned by GetBinary in place of linking. Check
+// LINK_STATUS: drivers reject binaries of other versions or hardware.
+func (program Program) Binary(format GLenum, binary []byte) {
+	if len(binary) == 0 {
+		panic("Invalid binary length")
+	}
+	forgetUniformPlans(program)
+	C.glProgramBinary(C.GLuint(program), C.GLenum(format), unsafe.Pointer(&binary[0]), C.GLsizei(len(binary)))
+}
+
+// Program Binary Cache
+
+// The source of a shader stage of a cached program
+type ShaderSource struct {
+	Type GLenum
+	Code string
+}
+
+// ProgramCache builds programs from source once and stores their binaries
+// in a directory, loading them on later runs instead of compiling. Binaries
+// are keyed by a hash of the sources, the defines and the RENDERER and
+// VERSION strings of the driver, so updating any of them builds anew.
+//
+// Damaged cache files, files written for other programs and binaries the
+// driver rejects are replaced by a build from source. All methods make GL
+// calls and must be called on the GL thread.
+type ProgramCache struct {
+	Dir     string
+	OnError func(error) // called with cache errors, which are otherwise ignored
+}
+
+// Magic number of cache files, followed by the key the file is named after,
+// the binary format, the binary length, the SHA-256 of the binary and the
+// binary itself
+var programCacheMagic = [4]byte{'G', 'L', 'P', 'B'}
+
+type programCacheHeader struct {
+	Magic  [4]byte
+	Key    [sha256.Size]byte
+	Format uint32
+	Length uint32
+	Sum    [sha256.Size]byte
+}
+
+// Creates a cache storing binaries in dir, which is created when first
+// written to. Cache errors are passed to onError, which may be nil.
+func NewProgramCache(dir string, onError func(error)) *ProgramCache {
+	return &ProgramCache{Dir: dir, OnError: onError}
+}
+
+// Program returns the program of shaders, loaded from the cache if possible
+// and built and stored otherwise. Every define, "NAME" or "NAME=VALUE", is
+// inserted as a #define after the #version directive of every shader.
+// Errors building from source are returned as *ShaderError; errors of the
+// cache itself only reach OnError.
+func (c *ProgramCache) Program(defines []string, shaders ...ShaderSource) (Program, error) {
+	sources := make([]ShaderSource, len(shaders))
+	for i, shader := range shaders {
+		sources[i] = ShaderSource{shader.Type, insertDefines(shader.Code, defines)}
+	}
+	key := programCacheKey(GetString(RENDERER), GetString(VERSION), defines, sources)
+	name := filepath.Join(c.Dir, hex.EncodeToString(key[:])+".bin")
+
+	format, binary, err := readProgramBinary(name, key)
+	if err == nil {
+		// Formats the driver no longer supports are not loaded, as Binary
+		// would raise INVALID_ENUM
+		if programBinaryFormatSupported(format) {
+			program := CreateProgram()
+			program.Binary(format, binary)
+			if program.Get(LINK_STATUS) != 0 {
+				return program, nil
+			}
+			program.Delete()
+		}
+		c.error(fmt.Errorf("gl: program binary %s rejected by the driver", name))
+	} else if !os.IsNotExist(err) {
+		c.error(err)
+	}
+
+	program, err := buildProgram(sources)
+	if err != nil {
+		return 0, err
+	}
+	if format, binary := program.GetBinary(); len(binary) > 0 {
+		if err := writeProgramBinary(name, key, format, binary); err != nil {
+			c.error(err)
+		}
+	}
+	return program, nil
+}
+
+// Reports whether format is one of the PROGRAM_BINARY_FORMATS of the driver
+func programBinaryFormatSupported(format GLenum) bool {
+	var n [1]int32
+	GetIntegerv(NUM_PROGRAM_BINARY_FORMATS, n[:])
+	if n[0] == 0 {
+		return false
+	}
+	formats := make([]int32, n[0])
+	GetIntegerv(PROGRAM_BINARY_FORMATS, formats)
+	for _, f := range formats {
+		if GLenum(f) == format {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ProgramCache) error(err error) {
+	if c.OnError != nil {
+		c.OnError(err)
+	}
+}
+
+// Hashes everything a program binary depends on: the RENDERER and VERSION
+// strings of the driver, the defines and the sources
+func programCacheKey(renderer, version string, defines []string, sources []ShaderSource) (key [sha256.Size]byte) {
+	h := sha256.New()
+	write := func(s string) {
+		binary.Write(h, binary.LittleEndian, uint64(len(s)))
+		h.Write([]byte(s))
+	}
+	write(renderer)
+	write(version)
+	binary.Write(h, binary.LittleEndian, uint64(len(defines)))
+	for _, define := range defines {
+		write(define)
+	}
+	for _, source := range sources {
+		binary.Write(h, binary.LittleEndian, uint32(source.Type))
+		write(source.Code)
+	}
+	copy(key[:], h.Sum(nil))
+	return key
+}
+
+// Inserts defines after the #version directive of code, numbering the
+// lines that follow as they were
+func insertDefines(code string, defines []string) string {
+	if len(defines) == 0 {
+		return code
+	}
+	lines := strings.SplitAfter(code, "\n")
+	version := 0
+	for i, line := range lines {
+		if versionDirective.MatchString(line) {
+			version = i + 1
+			break
+		}
+	}
+	var b strings.Builder
+	for _, line := range lines[:version] {
+		b.WriteString(line)
+	}
+	if version > 0 && !strings.HasSuffix(lines[version-1], "\n") {
+		b.WriteString("\n")
+	}
+	for _, define := range defines {
+		b.WriteString("#define " + strings.Replace(define, "=", " ", 1) + "\n")
+	}
+	fmt.Fprintf(&b, "#line %d\n", version+1)
+	for _, line := range lines[version:] {
+		b.WriteString(line)
+	}
+	return b.String()
+}
+
+// Compiles and links sources, asking for a retrievable binary
+func buildProgram(sources []ShaderSource) (Program, error) {
+	shaders := make([]Shader, 0, len(sources))
+	defer func() {
+		for _, shader := range shaders {
+			shader.Delete()
+		}
+	}()
+	for _, source := range sources {
+		shader, err := NewShader(source.Type, source.Code)
+		if err != nil {
+			return 0, err
+		}
+		shaders = append(shaders, shader)
+	}
+	program := CreateProgram()
+	program.Parameteri(PROGRAM_BINARY_RETRIEVABLE_HINT, TRUE)
+	return linkProgram(program, shaders...)
+}
+
+// Reads a cache file, checking that it is complete, undamaged and written
+// for key
+func readProgramBinary(name string, key [sha256.Size]byte) (GLenum, []byte, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return 0, nil, err
+	}
+	var header programCacheHeader
+	r := bytes.NewReader(data)
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil || header.Magic != programCacheMagic {
+		return 0, nil, fmt.Errorf("gl: %s is not a program binary", name)
+	}
+	if header.Key != key {
+		return 0, nil, fmt.Errorf("gl: program binary %s belongs to another program", name)
+	}
+	payload := data[len(data)-r.Len():]
+	if int(header.Length) != len(payload) || header.Length == 0 || sha256.Sum256(payload) != header.Sum {
+		return 0, nil, fmt.Errorf("gl: program binary %s is damaged", name)
+	}
+	return GLenum(header.Format), payload, nil
+}
+
+// Writes a cache file atomically, so that readers never see part of it
+func writeProgramBinary(name string, key [sha256.Size]byte, format GLenum, payload []byte) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(name), ".program-*.tmp")
+	if err != nil {
+		return err
+	}
+	header := programCacheHeader{programCacheMagic, key, uint32(format), uint32(len(payload)), sha256.Sum256(payload)}
+	err = binary.Write(f, binary.LittleEndian, &header)
+	if err == nil {
+		_, err = f.Write(payload)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), name)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
@@ -0,0 +1,154 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var cacheSources = []ShaderSource{
+	{VERTEX_SHADER, "#version 330\nvoid main() { gl_Position = vec4(SCALE); }\n"},
+	{FRAGMENT_SHADER, "#version 330\nout vec4 color;\nvoid main() { color = vec4(1); }\n"},
+}
+
+func TestProgramCacheKey(t *testing.T) {
+	key := programCacheKey("llvmpipe", "4.5 Mesa 23.0", []string{"SCALE=2.0"}, cacheSources)
+	if again := programCacheKey("llvmpipe", "4.5 Mesa 23.0", []string{"SCALE=2.0"}, cacheSources); again != key {
+		t.Errorf("programCacheKey is not deterministic")
+	}
+	other := []ShaderSource{cacheSources[0], {FRAGMENT_SHADER, cacheSources[1].Code + "\n"}}
+	swapped := []ShaderSource{{FRAGMENT_SHADER, cacheSources[0].Code}, {VERTEX_SHADER, cacheSources[1].Code}}
+	tests := []struct {
+		name              string
+		renderer, version string
+		defines           []string
+		sources           []ShaderSource
+	}{
+		{"renderer", "softpipe", "4.5 Mesa 23.0", []string{"SCALE=2.0"}, cacheSources},
+		{"version", "llvmpipe", "4.5 Mesa 23.1", []string{"SCALE=2.0"}, cacheSources},
+		{"define", "llvmpipe", "4.5 Mesa 23.0", []string{"SCALE=3.0"}, cacheSources},
+		{"no define", "llvmpipe", "4.5 Mesa 23.0", nil, cacheSources},
+		// Lengths are hashed, so moving bytes between strings changes the key
+		{"split define", "llvmpipe", "4.5 Mesa 23.0", []string{"SCALE", "=2.0"}, cacheSources},
+		{"split strings", "llvmpipe4", ".5 Mesa 23.0", []string{"SCALE=2.0"}, cacheSources},
+		{"source", "llvmpipe", "4.5 Mesa 23.0", []string{"SCALE=2.0"}, other},
+		{"stage", "llvmpipe", "4.5 Mesa 23.0", []string{"SCALE=2.0"}, swapped},
+	}
+	for _, test := range tests {
+		if programCacheKey(test.renderer, test.version, test.defines, test.sources) == key {
+			t.Errorf("%s: changing it leaves the key unchanged", test.name)
+		}
+	}
+}
+
+func TestReadProgramBinary(t *testing.T) {
+	dir := t.TempDir()
+	key := programCacheKey("llvmpipe", "4.5 Mesa 23.0", nil, cacheSources)
+	otherKey := programCacheKey("llvmpipe", "4.5 Mesa 23.0", []string{"DEBUG"}, cacheSources)
+	payload := bytes.Repeat([]byte("program binary "), 10)
+	name := filepath.Join(dir, "sub", "program.bin")
+	if err := writeProgramBinary(name, key, 0x8741, payload); err != nil {
+		t.Fatal(err)
+	}
+	format, binary, err := readProgramBinary(name, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != 0x8741 || !bytes.Equal(binary, payload) {
+		t.Errorf("readProgramBinary = 0x%x, %q, want 0x8741, %q", format, binary, payload)
+	}
+	if tmp, _ := filepath.Glob(filepath.Join(dir, "sub", ".program-*")); len(tmp) > 0 {
+		t.Errorf("writeProgramBinary left %v behind", tmp)
+	}
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	corrupt := func(i int) []byte {
+		b := append([]byte(nil), data...)
+		b[i] ^= 0x01
+		return b
+	}
+	tests := []struct {
+		name string
+		data []byte
+		key  [32]byte
+	}{
+		{"empty", nil, key},
+		{"truncated header", data[:20], key},
+		{"truncated payload", data[:len(data)-1], key},
+		{"header only", data[:len(data)-len(payload)], key},
+		{"trailing bytes", append(append([]byte(nil), data...), 0), key},
+		{"bad magic", corrupt(0), key},
+		{"corrupted key", corrupt(4), key},
+		{"corrupted length", corrupt(4 + 32 + 4), key},
+		{"corrupted sum", corrupt(4 + 32 + 8), key},
+		{"corrupted payload", corrupt(len(data) - 1), key},
+		{"wrong key", data, otherKey},
+	}
+	for _, test := range tests {
+		if err := os.WriteFile(name, test.data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if format, binary, err := readProgramBinary(name, test.key); err == nil {
+			t.Errorf("%s: readProgramBinary = 0x%x, %d bytes, want an error", test.name, format, len(binary))
+		} else if os.IsNotExist(err) {
+			t.Errorf("%s: readProgramBinary: %v, want a damaged file error", test.name, err)
+		}
+	}
+
+	if _, _, err := readProgramBinary(filepath.Join(dir, "missing.bin"), key); !os.IsNotExist(err) {
+		t.Errorf("readProgramBinary of a missing file: %v, want a not exist error", err)
+	}
+}
+
+func TestInsertDefines(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		defines []string
+		want    string
+	}{
+		{
+			"after #version",
+			"#version 330\nvoid main() {}\n",
+			[]string{"A", "SCALE=2.0"},
+			"#version 330\n#define A\n#define SCALE 2.0\n#line 2\nvoid main() {}\n",
+		},
+		{
+			"#version after comments",
+			"// shader\n\n  #version 450 core\nfloat x;\nvoid main() {}\n",
+			[]string{"A=B=C"},
+			"// shader\n\n  #version 450 core\n#define A B=C\n#line 4\nfloat x;\nvoid main() {}\n",
+		},
+		{
+			"#version without newline",
+			"#version 330",
+			[]string{"A"},
+			"#version 330\n#define A\n#line 2\n",
+		},
+		{
+			"no #version",
+			"void main() {}\n",
+			[]string{"A"},
+			"#define A\n#line 1\nvoid main() {}\n",
+		},
+		{
+			"no defines",
+			"#version 330\nvoid main() {}\n",
+			nil,
+			"#version 330\nvoid main() {}\n",
+		},
+	}
+	for _, test := range tests {
+		if got := insertDefines(test.code, test.defines); got != test.want {
+			t.Errorf("%s: insertDefines(%q, %q) =\n%q\nwant\n%q", test.name, test.code, test.defines, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+// #include "gl.h"
+import "C"
+
+// Program Interface Queries
+
+// void glGetProgramInterfaceiv(GLuint program, GLenum programInterface, GLenum pname, GLint *params);
+func (program Program) GetProgramInterfaceiv(programInterface, pname GLenum) int {
+	var rv C.GLint
+
+	C.glGetProgramInterfaceiv(C.GLuint(program), C.GLenum(programInterface), C.GLenum(pname), &rv)
+	return int(rv)
+}
+
+// GLuint glGetProgramResourceIndex(GLuint program, GLenum programInterface, const GLchar *name);
+//
+// Returns INVALID_INDEX if the interface has no active resource of that name.
+func (program Program) GetProgramResourceIndex(programInterface GLenum, name string) uint {
+
+	cname := glString(name)
+	defer freeString(cname)
+
+	return uint(C.glGetProgramResourceIndex(C.GLuint(program), C.GLenum(programInterface), cname))
+}
+
+// void glGetProgramResourceName(GLuint program, GLenum programInterface, GLuint index, GLsizei bufSize, GLsizei *length, GLchar *name);
+func (program Program) GetProgramResourceName(programInterface GLenum, index uint) string {
+	bufSize := program.GetProgramInterfaceiv(programInterface, MAX_NAME_LENGTH)
+	if bufSize < 1 {
+		return ""
+	}
+	nameBuf := C.malloc(C.size_t(bufSize))
+	defer C.free(nameBuf)
+	C.glGetProgramResourceName(C.GLuint(program), C.GLenum(programInterface), C.GLuint(index),
+		C.GLsizei(bufSize), nil, (*C.GLchar)(nameBuf))
+	return C.GoString((*C.char)(nameBuf))
+}
+
+// void glGetProgramResourceiv(GLuint program, GLenum programInterface, GLuint index, GLsizei propCount, const GLenum *props, GLsizei bufSize, GLsizei *length, GLint *params);
+//
+// Fills params with the values of props, in order, and returns how many
+// values were written.
+func (program Program) GetProgramResourceiv(programInterface GLenum, index uint, props []GLenum, params []int32) int {
+	if len(props) == 0 || len(params) == 0 {
+		panic("Invalid props or params length")
+	}
+	var length C.GLsizei
+	C.glGetProgramResourceiv(C.GLuint(program), C.GLenum(programInterface), C.GLuint(index),
+		C.GLsizei(len(props)), (*C.GLenum)(&props[0]), C.GLsizei(len(params)), &length, (*C.GLint)(&params[0]))
+	return int(length)
+}
@@ -0,0 +1,123 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+// #include "gl.h"
+import "C"
+
+// Separate Shader Objects
+
+// void glProgramParameteri(GLuint program, GLenum pname, GLint value);
+//
+// Set PROGRAM_SEPARABLE before linking a program to use it in a
+// ProgramPipeline.
+func (program Program) Parameteri(pname GLenum, value int) {
+	C.glProgramParameteri(C.GLuint(program), C.GLenum(pname), C.GLint(value))
+}
+
+// GLuint glCreateShaderProgramv(GLenum type, GLsizei count, const char **strings);
+//
+// Compiles a shader of type typ from the concatenation of strings and links
+// it into a new separable program. Check LINK_STATUS and GetInfoLog, which
+// holds the compiler log too, for errors.
+func CreateShaderProgramv(typ GLenum, strings ...string) Program {
+	if len(strings) == 0 {
+		panic("Invalid strings length")
+	}
+	cstrings := make([]*C.GLchar, len(strings))
+	for i := range strings {
+		cstrings[i] = glString(strings[i])
+	}
+	defer func() {
+		for _, s := range cstrings {
+			freeString(s)
+		}
+	}()
+	return Program(C.glCreateShaderProgramv(C.GLenum(typ), C.GLsizei(len(cstrings)), &cstrings[0]))
+}
+
+// Program Pipeline Objects
+
+type ProgramPipeline Object
+
+// void glGenProgramPipelines(GLsizei n, GLuint *pipelines);
+func GenProgramPipeline() ProgramPipeline {
+	var p C.GLuint
+	C.glGenProgramPipelines(1, &p)
+	return ProgramPipeline(p)
+}
+
+// Fill slice with new program pipelines
+func GenProgramPipelines(pipelines []ProgramPipeline) {
+	if len(pipelines) > 0 {
+		C.glGenProgramPipelines(C.GLsizei(len(pipelines)), (*C.GLuint)(&pipelines[0]))
+	}
+}
+
+// void glDeleteProgramPipelines(GLsizei n, const GLuint *pipelines);
+func (pipeline ProgramPipeline) Delete() {
+	C.glDeleteProgramPipelines(1, (*C.GLuint)(&pipeline))
+}
+
+// Delete all program pipelines in a slice
+func DeleteProgramPipelines(pipelines []ProgramPipeline) {
+	if len(pipelines) > 0 {
+		C.glDeleteProgramPipelines(C.GLsizei(len(pipelines)), (*C.GLuint)(&pipelines[0]))
+	}
+}
+
+// void glBindProgramPipeline(GLuint pipeline);
+//
+// The pipeline is only used while no program is, see ProgramUnuse.
+func (pipeline ProgramPipeline) Bind() {
+	C.glBindProgramPipeline(C.GLuint(pipeline))
+}
+
+// Unbind the current program pipeline
+func (pipeline ProgramPipeline) Unbind() {
+	C.glBindProgramPipeline(0)
+}
+
+// void glUseProgramStages(GLuint pipeline, GLbitfield stages, GLuint program);
+//
+// Use the stages of program, such as VERTEX_SHADER_BIT|FRAGMENT_SHADER_BIT,
+// in this pipeline. A program of 0 clears the stages.
+func (pipeline ProgramPipeline) UseProgramStages(stages GLbitfield, program Program) {
+	C.glUseProgramStages(C.GLuint(pipeline), C.GLbitfield(stages), C.GLuint(program))
+}
+
+// void glActiveShaderProgram(GLuint pipeline, GLuint program);
+//
+// Make program the target of the Uniform* functions of UniformLocation
+// while this pipeline is bound.
+func (pipeline ProgramPipeline) ActiveShaderProgram(program Program) {
+	C.glActiveShaderProgram(C.GLuint(pipeline), C.GLuint(program))
+}
+
+// void glValidateProgramPipeline(GLuint pipeline);
+func (pipeline ProgramPipeline) Validate() {
+	C.glValidateProgramPipeline(C.GLuint(pipeline))
+}
+
+// void glGetProgramPipelineiv(GLuint pipeline, GLenum pname, GLint *params);
+func (pipeline ProgramPipeline) Get(pname GLenum) int {
+	var rv C.GLint
+	C.glGetProgramPipelineiv(C.GLuint(pipeline), C.GLenum(pname), &rv)
+	return int(rv)
+}
+
+// void glGetProgramPipelineInfoLog(GLuint pipeline, GLsizei bufSize, GLsizei *length, GLchar *infoLog);
+func (pipeline ProgramPipeline) GetInfoLog() string {
+	length := pipeline.Get(INFO_LOG_LENGTH)
+	// length is buffer size including null character
+
+	if length > 1 {
+		log := C.malloc(C.size_t(length))
+		defer C.free(log)
+		C.glGetProgramPipelineInfoLog(C.GLuint(pipeline), C.GLsizei(length), nil, (*C.GLchar)(log))
+		return C.GoString((*C.char)(log))
+	}
+	return ""
+}
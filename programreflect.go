@@ -0,0 +1,281 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import "fmt"
+
+// Program Reflection
+
+// An active vertex shader input or fragment shader output
+type ActiveVariable struct {
+	Name      string
+	Type      GLenum
+	TypeName  string // GLSL name of Type, e.g. "vec3"
+	ArraySize int
+	Location  int
+}
+
+// An active uniform, or a buffer variable of a shader storage block
+type ActiveUniform struct {
+	Name      string
+	Type      GLenum
+	TypeName  string // GLSL name of Type, e.g. "sampler2D"
+	ArraySize int
+	Location  int // -1 for members of blocks
+
+	// Block index of the uniform block or shader storage block holding
+	// the variable, -1 for uniforms of the default block
+	Block        int
+	Offset       int // in bytes from the start of the block, -1 outside blocks
+	ArrayStride  int
+	MatrixStride int
+	RowMajor     bool
+
+	// Of the outermost array of buffer variables, 0 for uniforms
+	TopLevelArraySize   int
+	TopLevelArrayStride int
+}
+
+// An active uniform block or shader storage block
+type ActiveBlock struct {
+	Name      string
+	Binding   int // buffer binding point
+	DataSize  int // minimum size in bytes of the buffer backing the block
+	Variables []ActiveUniform
+}
+
+// The interface of a linked program, as returned by Program.Reflect
+type ProgramReflection struct {
+	Attributes    []ActiveVariable
+	Uniforms      []ActiveUniform // includes the members of uniform blocks
+	UniformBlocks []ActiveBlock   // indexed by block index
+	StorageBlocks []ActiveBlock   // indexed by block index
+	Outputs       []ActiveVariable
+}
+
+// Reflect lists the active resources of a linked program with the program
+// interface queries of GL 4.3. Resources are in the order of their index;
+// built-in inputs and outputs are included with a Location of -1.
+func (program Program) Reflect() *ProgramReflection {
+	return &ProgramReflection{
+		Attributes:    program.activeVariables(PROGRAM_INPUT),
+		Uniforms:      program.activeUniforms(UNIFORM, nil),
+		UniformBlocks: program.activeBlocks(UNIFORM_BLOCK, UNIFORM),
+		StorageBlocks: program.activeBlocks(SHADER_STORAGE_BLOCK, BUFFER_VARIABLE),
+		Outputs:       program.activeVariables(PROGRAM_OUTPUT),
+	}
+}
+
+func (program Program) activeVariables(programInterface GLenum) []ActiveVariable {
+	n := program.GetProgramInterfaceiv(programInterface, ACTIVE_RESOURCES)
+	props := []GLenum{TYPE, ARRAY_SIZE, LOCATION}
+	values := make([]int32, len(props))
+	variables := make([]ActiveVariable, n)
+	for i := range variables {
+		program.GetProgramResourceiv(programInterface, uint(i), props, values)
+		variables[i] = ActiveVariable{
+			Name:      program.GetProgramResourceName(programInterface, uint(i)),
+			Type:      GLenum(values[0]),
+			TypeName:  GLSLTypeName(GLenum(values[0])),
+			ArraySize: int(values[1]),
+			Location:  int(values[2]),
+		}
+	}
+	return variables
+}
+
+// Returns the uniforms or buffer variables of programInterface with the
+// given indices, or all of them if indices is nil
+func (program Program) activeUniforms(programInterface GLenum, indices []int32) []ActiveUniform {
+	if indices == nil {
+		n := program.GetProgramInterfaceiv(programInterface, ACTIVE_RESOURCES)
+		indices = make([]int32, n)
+		for i := range indices {
+			indices[i] = int32(i)
+		}
+	}
+	props := []GLenum{TYPE, ARRAY_SIZE, BLOCK_INDEX, OFFSET, ARRAY_STRIDE, MATRIX_STRIDE, IS_ROW_MAJOR}
+	if programInterface == UNIFORM {
+		props = append(props, LOCATION)
+	} else {
+		props = append(props, TOP_LEVEL_ARRAY_SIZE, TOP_LEVEL_ARRAY_STRIDE)
+	}
+	values := make([]int32, len(props))
+	uniforms := make([]ActiveUniform, len(indices))
+	for i, index := range indices {
+		program.GetProgramResourceiv(programInterface, uint(index), props, values)
+		u := ActiveUniform{
+			Name:         program.GetProgramResourceName(programInterface, uint(index)),
+			Type:         GLenum(values[0]),
+			TypeName:     GLSLTypeName(GLenum(values[0])),
+			ArraySize:    int(values[1]),
+			Location:     -1,
+			Block:        int(values[2]),
+			Offset:       int(values[3]),
+			ArrayStride:  int(values[4]),
+			MatrixStride: int(values[5]),
+			RowMajor:     values[6] != 0,
+		}
+		if programInterface == UNIFORM {
+			u.Location = int(values[7])
+		} else {
+			u.TopLevelArraySize, u.TopLevelArrayStride = int(values[7]), int(values[8])
+		}
+		uniforms[i] = u
+	}
+	return uniforms
+}
+
+func (program Program) activeBlocks(programInterface, variableInterface GLenum) []ActiveBlock {
+	n := program.GetProgramInterfaceiv(programInterface, ACTIVE_RESOURCES)
+	props := []GLenum{BUFFER_BINDING, BUFFER_DATA_SIZE, NUM_ACTIVE_VARIABLES}
+	values := make([]int32, len(props))
+	blocks := make([]ActiveBlock, n)
+	for i := range blocks {
+		program.GetProgramResourceiv(programInterface, uint(i), props, values)
+		blocks[i] = ActiveBlock{
+			Name:     program.GetProgramResourceName(programInterface, uint(i)),
+			Binding:  int(values[0]),
+			DataSize: int(values[1]),
+		}
+		if values[2] > 0 {
+			indices := make([]int32, values[2])
+			program.GetProgramResourceiv(programInterface, uint(i), []GLenum{ACTIVE_VARIABLES}, indices)
+			blocks[i].Variables = program.activeUniforms(variableInterface, indices)
+		}
+	}
+	return blocks
+}
+
+var glslTypeNames = map[GLenum]string{
+	FLOAT:             "float",
+	FLOAT_VEC2:        "vec2",
+	FLOAT_VEC3:        "vec3",
+	FLOAT_VEC4:        "vec4",
+	DOUBLE:            "double",
+	DOUBLE_VEC2:       "dvec2",
+	DOUBLE_VEC3:       "dvec3",
+	DOUBLE_VEC4:       "dvec4",
+	INT:               "int",
+	INT_VEC2:          "ivec2",
+	INT_VEC3:          "ivec3",
+	INT_VEC4:          "ivec4",
+	UNSIGNED_INT:      "uint",
+	UNSIGNED_INT_VEC2: "uvec2",
+	UNSIGNED_INT_VEC3: "uvec3",
+	UNSIGNED_INT_VEC4: "uvec4",
+	BOOL:              "bool",
+	BOOL_VEC2:         "bvec2",
+	BOOL_VEC3:         "bvec3",
+	BOOL_VEC4:         "bvec4",
+	FLOAT_MAT2:        "mat2",
+	FLOAT_MAT3:        "mat3",
+	FLOAT_MAT4:        "mat4",
+	FLOAT_MAT2x3:      "mat2x3",
+	FLOAT_MAT2x4:      "mat2x4",
+	FLOAT_MAT3x2:      "mat3x2",
+	FLOAT_MAT3x4:      "mat3x4",
+	FLOAT_MAT4x2:      "mat4x2",
+	FLOAT_MAT4x3:      "mat4x3",
+	DOUBLE_MAT2:       "dmat2",
+	DOUBLE_MAT3:       "dmat3",
+	DOUBLE_MAT4:       "dmat4",
+	DOUBLE_MAT2x3:     "dmat2x3",
+	DOUBLE_MAT2x4:     "dmat2x4",
+	DOUBLE_MAT3x2:     "dmat3x2",
+	DOUBLE_MAT3x4:     "dmat3x4",
+	DOUBLE_MAT4x2:     "dmat4x2",
+	DOUBLE_MAT4x3:     "dmat4x3",
+
+	SAMPLER_1D:                    "sampler1D",
+	SAMPLER_2D:                    "sampler2D",
+	SAMPLER_3D:                    "sampler3D",
+	SAMPLER_CUBE:                  "samplerCube",
+	SAMPLER_1D_SHADOW:             "sampler1DShadow",
+	SAMPLER_2D_SHADOW:             "sampler2DShadow",
+	SAMPLER_1D_ARRAY:              "sampler1DArray",
+	SAMPLER_2D_ARRAY:              "sampler2DArray",
+	SAMPLER_1D_ARRAY_SHADOW:       "sampler1DArrayShadow",
+	SAMPLER_2D_ARRAY_SHADOW:       "sampler2DArrayShadow",
+	SAMPLER_2D_MULTISAMPLE:        "sampler2DMS",
+	SAMPLER_2D_MULTISAMPLE_ARRAY:  "sampler2DMSArray",
+	SAMPLER_CUBE_SHADOW:           "samplerCubeShadow",
+	SAMPLER_BUFFER:                "samplerBuffer",
+	SAMPLER_2D_RECT:               "sampler2DRect",
+	SAMPLER_2D_RECT_SHADOW:        "sampler2DRectShadow",
+	SAMPLER_CUBE_MAP_ARRAY:        "samplerCubeArray",
+	SAMPLER_CUBE_MAP_ARRAY_SHADOW: "samplerCubeArrayShadow",
+
+	INT_SAMPLER_1D:                   "isampler1D",
+	INT_SAMPLER_2D:                   "isampler2D",
+	INT_SAMPLER_3D:                   "isampler3D",
+	INT_SAMPLER_CUBE:                 "isamplerCube",
+	INT_SAMPLER_1D_ARRAY:             "isampler1DArray",
+	INT_SAMPLER_2D_ARRAY:             "isampler2DArray",
+	INT_SAMPLER_2D_MULTISAMPLE:       "isampler2DMS",
+	INT_SAMPLER_2D_MULTISAMPLE_ARRAY: "isampler2DMSArray",
+	INT_SAMPLER_BUFFER:               "isamplerBuffer",
+	INT_SAMPLER_2D_RECT:              "isampler2DRect",
+	INT_SAMPLER_CUBE_MAP_ARRAY:       "isamplerCubeArray",
+
+	UNSIGNED_INT_SAMPLER_1D:                   "usampler1D",
+	UNSIGNED_INT_SAMPLER_2D:                   "usampler2D",
+	UNSIGNED_INT_SAMPLER_3D:                   "usampler3D",
+	UNSIGNED_INT_SAMPLER_CUBE:                 "usamplerCube",
+	UNSIGNED_INT_SAMPLER_1D_ARRAY:             "usampler1DArray",
+	UNSIGNED_INT_SAMPLER_2D_ARRAY:             "usampler2DArray",
+	UNSIGNED_INT_SAMPLER_2D_MULTISAMPLE:       "usampler2DMS",
+	UNSIGNED_INT_SAMPLER_2D_MULTISAMPLE_ARRAY: "usampler2DMSArray",
+	UNSIGNED_INT_SAMPLER_BUFFER:               "usamplerBuffer",
+	UNSIGNED_INT_SAMPLER_2D_RECT:              "usampler2DRect",
+	UNSIGNED_INT_SAMPLER_CUBE_MAP_ARRAY:       "usamplerCubeArray",
+
+	IMAGE_1D:                   "image1D",
+	IMAGE_2D:                   "image2D",
+	IMAGE_3D:                   "image3D",
+	IMAGE_2D_RECT:              "image2DRect",
+	IMAGE_CUBE:                 "imageCube",
+	IMAGE_BUFFER:               "imageBuffer",
+	IMAGE_1D_ARRAY:             "image1DArray",
+	IMAGE_2D_ARRAY:             "image2DArray",
+	IMAGE_CUBE_MAP_ARRAY:       "imageCubeArray",
+	IMAGE_2D_MULTISAMPLE:       "image2DMS",
+	IMAGE_2D_MULTISAMPLE_ARRAY: "image2DMSArray",
+
+	INT_IMAGE_1D:                   "iimage1D",
+	INT_IMAGE_2D:                   "iimage2D",
+	INT_IMAGE_3D:                   "iimage3D",
+	INT_IMAGE_2D_RECT:              "iimage2DRect",
+	INT_IMAGE_CUBE:                 "iimageCube",
+	INT_IMAGE_BUFFER:               "iimageBuffer",
+	INT_IMAGE_1D_ARRAY:             "iimage1DArray",
+	INT_IMAGE_2D_ARRAY:             "iimage2DArray",
+	INT_IMAGE_CUBE_MAP_ARRAY:       "iimageCubeArray",
+	INT_IMAGE_2D_MULTISAMPLE:       "iimage2DMS",
+	INT_IMAGE_2D_MULTISAMPLE_ARRAY: "iimage2DMSArray",
+
+	UNSIGNED_INT_IMAGE_1D:                   "uimage1D",
+	UNSIGNED_INT_IMAGE_2D:                   "uimage2D",
+	UNSIGNED_INT_IMAGE_3D:                   "uimage3D",
+	UNSIGNED_INT_IMAGE_2D_RECT:              "uimage2DRect",
+	UNSIGNED_INT_IMAGE_CUBE:                 "uimageCube",
+	UNSIGNED_INT_IMAGE_BUFFER:               "uimageBuffer",
+	UNSIGNED_INT_IMAGE_1D_ARRAY:             "uimage1DArray",
+	UNSIGNED_INT_IMAGE_2D_ARRAY:             "uimage2DArray",
+	UNSIGNED_INT_IMAGE_CUBE_MAP_ARRAY:       "uimageCubeArray",
+	UNSIGNED_INT_IMAGE_2D_MULTISAMPLE:       "uimage2DMS",
+	UNSIGNED_INT_IMAGE_2D_MULTISAMPLE_ARRAY: "uimage2DMSArray",
+
+	UNSIGNED_INT_ATOMIC_COUNTER: "atomic_uint",
+}
+
+// GLSLTypeName returns the GLSL spelling of a type enum as reported by the
+// active resource queries, e.g. "mat4" for FLOAT_MAT4.
+func GLSLTypeName(typ GLenum) string {
+	if name, ok := glslTypeNames[typ]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%x", uint32(typ))
+}
@@ -0,0 +1,314 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+// #include "gl.h"
+import "C"
+
+// Program Uniforms
+//
+// These set the uniforms of a program whether or not it is in use.
+
+func (program Program) Uniform1f(location UniformLocation, x float32) {
+	C.glProgramUniform1f(C.GLuint(program), C.GLint(location), C.GLfloat(x))
+}
+
+func (program Program) Uniform2f(location UniformLocation, x float32, y float32) {
+	C.glProgramUniform2f(C.GLuint(program), C.GLint(location), C.GLfloat(x), C.GLfloat(y))
+}
+
+func (program Program) Uniform3f(location UniformLocation, x float32, y float32, z float32) {
+	C.glProgramUniform3f(C.GLuint(program), C.GLint(location), C.GLfloat(x), C.GLfloat(y), C.GLfloat(z))
+}
+
+func (program Program) Uniform4f(location UniformLocation, x float32, y float32, z float32, w float32) {
+	C.glProgramUniform4f(C.GLuint(program), C.GLint(location), C.GLfloat(x), C.GLfloat(y), C.GLfloat(z), C.GLfloat(w))
+}
+
+func (program Program) Uniform1fv(location UniformLocation, list ...float32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform1fv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLfloat)(&list[0]))
+}
+
+func (program Program) Uniform2fv(location UniformLocation, list ...[2]float32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform2fv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLfloat)(&list[0][0]))
+}
+
+func (program Program) Uniform3fv(location UniformLocation, list ...[3]float32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform3fv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLfloat)(&list[0][0]))
+}
+
+func (program Program) Uniform4fv(location UniformLocation, list ...[4]float32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform4fv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLfloat)(&list[0][0]))
+}
+
+func (program Program) Uniform1i(location UniformLocation, x int) {
+	C.glProgramUniform1i(C.GLuint(program), C.GLint(location), C.GLint(x))
+}
+
+func (program Program) Uniform2i(location UniformLocation, x int, y int) {
+	C.glProgramUniform2i(C.GLuint(program), C.GLint(location), C.GLint(x), C.GLint(y))
+}
+
+func (program Program) Uniform3i(location UniformLocation, x int, y int, z int) {
+	C.glProgramUniform3i(C.GLuint(program), C.GLint(location), C.GLint(x), C.GLint(y), C.GLint(z))
+}
+
+func (program Program) Uniform4i(location UniformLocation, x int, y int, z int, w int) {
+	C.glProgramUniform4i(C.GLuint(program), C.GLint(location), C.GLint(x), C.GLint(y), C.GLint(z), C.GLint(w))
+}
+
+func (program Program) Uniform1iv(location UniformLocation, list ...int32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform1iv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLint)(&list[0]))
+}
+
+func (program Program) Uniform2iv(location UniformLocation, list ...[2]int32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform2iv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLint)(&list[0][0]))
+}
+
+func (program Program) Uniform3iv(location UniformLocation, list ...[3]int32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform3iv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLint)(&list[0][0]))
+}
+
+func (program Program) Uniform4iv(location UniformLocation, list ...[4]int32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform4iv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLint)(&list[0][0]))
+}
+
+func (program Program) Uniform1ui(location UniformLocation, x uint32) {
+	C.glProgramUniform1ui(C.GLuint(program), C.GLint(location), C.GLuint(x))
+}
+
+func (program Program) Uniform2ui(location UniformLocation, x uint32, y uint32) {
+	C.glProgramUniform2ui(C.GLuint(program), C.GLint(location), C.GLuint(x), C.GLuint(y))
+}
+
+func (program Program) Uniform3ui(location UniformLocation, x uint32, y uint32, z uint32) {
+	C.glProgramUniform3ui(C.GLuint(program), C.GLint(location), C.GLuint(x), C.GLuint(y), C.GLuint(z))
+}
+
+func (program Program) Uniform4ui(location UniformLocation, x uint32, y uint32, z uint32, w uint32) {
+	C.glProgramUniform4ui(C.GLuint(program), C.GLint(location), C.GLuint(x), C.GLuint(y), C.GLuint(z), C.GLuint(w))
+}
+
+func (program Program) Uniform1uiv(location UniformLocation, list ...uint32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform1uiv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLuint)(&list[0]))
+}
+
+func (program Program) Uniform2uiv(location UniformLocation, list ...[2]uint32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform2uiv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLuint)(&list[0][0]))
+}
+
+func (program Program) Uniform3uiv(location UniformLocation, list ...[3]uint32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform3uiv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLuint)(&list[0][0]))
+}
+
+func (program Program) Uniform4uiv(location UniformLocation, list ...[4]uint32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform4uiv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLuint)(&list[0][0]))
+}
+
+func (program Program) Uniform1d(location UniformLocation, x float64) {
+	C.glProgramUniform1d(C.GLuint(program), C.GLint(location), C.GLdouble(x))
+}
+
+func (program Program) Uniform2d(location UniformLocation, x float64, y float64) {
+	C.glProgramUniform2d(C.GLuint(program), C.GLint(location), C.GLdouble(x), C.GLdouble(y))
+}
+
+func (program Program) Uniform3d(location UniformLocation, x float64, y float64, z float64) {
+	C.glProgramUniform3d(C.GLuint(program), C.GLint(location), C.GLdouble(x), C.GLdouble(y), C.GLdouble(z))
+}
+
+func (program Program) Uniform4d(location UniformLocation, x float64, y float64, z float64, w float64) {
+	C.glProgramUniform4d(C.GLuint(program), C.GLint(location), C.GLdouble(x), C.GLdouble(y), C.GLdouble(z), C.GLdouble(w))
+}
+
+func (program Program) Uniform1dv(location UniformLocation, list ...float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform1dv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLdouble)(&list[0]))
+}
+
+func (program Program) Uniform2dv(location UniformLocation, list ...[2]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform2dv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLdouble)(&list[0][0]))
+}
+
+func (program Program) Uniform3dv(location UniformLocation, list ...[3]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform3dv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLdouble)(&list[0][0]))
+}
+
+func (program Program) Uniform4dv(location UniformLocation, list ...[4]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniform4dv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), (*C.GLdouble)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix2fv(location UniformLocation, transpose bool, list ...[4]float32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix2fv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLfloat)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix3fv(location UniformLocation, transpose bool, list ...[9]float32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix3fv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLfloat)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix4fv(location UniformLocation, transpose bool, list ...[16]float32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix4fv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLfloat)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix2x3fv(location UniformLocation, transpose bool, list ...[6]float32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix2x3fv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLfloat)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix3x2fv(location UniformLocation, transpose bool, list ...[6]float32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix3x2fv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLfloat)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix2x4fv(location UniformLocation, transpose bool, list ...[8]float32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix2x4fv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLfloat)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix4x2fv(location UniformLocation, transpose bool, list ...[8]float32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix4x2fv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLfloat)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix3x4fv(location UniformLocation, transpose bool, list ...[12]float32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix3x4fv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLfloat)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix4x3fv(location UniformLocation, transpose bool, list ...[12]float32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix4x3fv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLfloat)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix2dv(location UniformLocation, transpose bool, list ...[4]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix2dv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix3dv(location UniformLocation, transpose bool, list ...[9]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix3dv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix4dv(location UniformLocation, transpose bool, list ...[16]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix4dv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix2x3dv(location UniformLocation, transpose bool, list ...[6]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix2x3dv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix3x2dv(location UniformLocation, transpose bool, list ...[6]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix3x2dv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix2x4dv(location UniformLocation, transpose bool, list ...[8]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix2x4dv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix4x2dv(location UniformLocation, transpose bool, list ...[8]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix4x2dv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix3x4dv(location UniformLocation, transpose bool, list ...[12]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix3x4dv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (program Program) UniformMatrix4x3dv(location UniformLocation, transpose bool, list ...[12]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glProgramUniformMatrix4x3dv(C.GLuint(program), C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
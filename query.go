@@ -0,0 +1,106 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+// #include "gl.h"
+import "C"
+
+// Query Objects
+
+type Query Object
+
+// void glGenQueries(GLsizei n, GLuint *ids);
+func GenQuery() Query {
+	var q C.GLuint
+	C.glGenQueries(1, &q)
+	return Query(q)
+}
+
+// Fill slice with new queries
+func GenQueries(queries []Query) {
+	if len(queries) > 0 {
+		C.glGenQueries(C.GLsizei(len(queries)), (*C.GLuint)(&queries[0]))
+	}
+}
+
+// void glDeleteQueries(GLsizei n, const GLuint *ids);
+func (query Query) Delete() {
+	C.glDeleteQueries(1, (*C.GLuint)(&query))
+}
+
+// Delete all queries in a slice
+func DeleteQueries(queries []Query) {
+	if len(queries) > 0 {
+		C.glDeleteQueries(C.GLsizei(len(queries)), (*C.GLuint)(&queries[0]))
+	}
+}
+
+// void glBeginQuery(GLenum target, GLuint id);
+func (query Query) Begin(target GLenum) {
+	C.glBeginQuery(C.GLenum(target), C.GLuint(query))
+}
+
+// void glEndQuery(GLenum target);
+func EndQuery(target GLenum) {
+	C.glEndQuery(C.GLenum(target))
+}
+
+// void glBeginQueryIndexed(GLenum target, GLuint index, GLuint id);
+//
+// Begin a query of vertex stream index, for the PRIMITIVES_GENERATED and
+// TRANSFORM_FEEDBACK_PRIMITIVES_WRITTEN targets.
+func (query Query) BeginIndexed(target GLenum, index uint) {
+	C.glBeginQueryIndexed(C.GLenum(target), C.GLuint(index), C.GLuint(query))
+}
+
+// void glEndQueryIndexed(GLenum target, GLuint index);
+func EndQueryIndexed(target GLenum, index uint) {
+	C.glEndQueryIndexed(C.GLenum(target), C.GLuint(index))
+}
+
+// void glGetQueryiv(GLenum target, GLenum pname, GLint *params);
+func GetQueryiv(target GLenum, pname GLenum) int {
+	var rv C.GLint
+	C.glGetQueryiv(C.GLenum(target), C.GLenum(pname), &rv)
+	return int(rv)
+}
+
+// void glGetQueryIndexediv(GLenum target, GLuint index, GLenum pname, GLint *params);
+func GetQueryIndexediv(target GLenum, index uint, pname GLenum) int {
+	var rv C.GLint
+	C.glGetQueryIndexediv(C.GLenum(target), C.GLuint(index), C.GLenum(pname), &rv)
+	return int(rv)
+}
+
+// void glGetQueryObjectiv(GLuint id, GLenum pname, GLint *params);
+//
+// Querying QUERY_RESULT waits for the result; QUERY_RESULT_AVAILABLE does
+// not.
+func (query Query) GetObjectiv(pname GLenum) int32 {
+	var rv C.GLint
+	C.glGetQueryObjectiv(C.GLuint(query), C.GLenum(pname), &rv)
+	return int32(rv)
+}
+
+// void glGetQueryObjectuiv(GLuint id, GLenum pname, GLuint *params);
+func (query Query) GetObjectuiv(pname GLenum) uint32 {
+	var rv C.GLuint
+	C.glGetQueryObjectuiv(C.GLuint(query), C.GLenum(pname), &rv)
+	return uint32(rv)
+}
+
+// void glGetQueryObjecti64v(GLuint id, GLenum pname, GLint64 *params);
+func (query Query) GetObjecti64v(pname GLenum) int64 {
+	var rv C.GLint64
+	C.glGetQueryObjecti64v(C.GLuint(query), C.GLenum(pname), &rv)
+	return int64(rv)
+}
+
+// void glGetQueryObjectui64v(GLuint id, GLenum pname, GLuint64 *params);
+func (query Query) GetObjectui64v(pname GLenum) uint64 {
+	var rv C.GLuint64
+	C.glGetQueryObjectui64v(C.GLuint(query), C.GLenum(pname), &rv)
+	return uint64(rv)
+}
@@ -0,0 +1,212 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import (
+	"io/fs"
+	"time"
+)
+
+// Shader Hot Reloading
+
+// A shader stage of a watched program, read with Preprocess
+type ShaderFile struct {
+	Type GLenum
+	Name string
+}
+
+// Watcher rebuilds programs whenever one of their shader files, or a file
+// these include, changes. It polls modification times, so it works with any
+// fs.FS whose files report them, such as os.DirFS.
+//
+// All methods make GL calls and must be called on the GL thread.
+type Watcher struct {
+	FS       fs.FS
+	Interval time.Duration // minimum time between two polls
+	OnError  func(error)   // called with errors of failed rebuilds
+
+	programs []*WatchedProgram
+	last     time.Time
+}
+
+// A program built and rebuilt by a Watcher
+type WatchedProgram struct {
+	// The last program that built successfully. Its value changes on
+	// reload, so read it every time the program is used.
+	Program Program
+	// Called after Program was replaced, to set uniform values and block
+	// bindings anew.
+	OnReload func(program Program)
+
+	shaders  []ShaderFile
+	files    map[string]fileStamp
+	uniforms map[string]UniformLocation
+}
+
+// What a file looked like when last read
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+	exists  bool
+}
+
+func stampFile(fsys fs.FS, name string) fileStamp {
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		return fileStamp{}
+	}
+	return fileStamp{info.ModTime(), info.Size(), true}
+}
+
+// Compares modification times with Equal, as == also compares the location
+// and monotonic reading of time.Time
+func (stamp fileStamp) equal(other fileStamp) bool {
+	return stamp.modTime.Equal(other.modTime) && stamp.size == other.size && stamp.exists == other.exists
+}
+
+// Creates a watcher of the files of fsys, polling at most every interval.
+// Errors of rebuilds are passed to onError, which may be nil.
+func NewWatcher(fsys fs.FS, interval time.Duration, onError func(error)) *Watcher {
+	return &Watcher{FS: fsys, Interval: interval, OnError: onError}
+}
+
+// Watch builds a program from shaders and rebuilds it on Poll whenever they
+// change. Errors building the first program are returned as there is no
+// program to fall back on.
+func (w *Watcher) Watch(shaders ...ShaderFile) (*WatchedProgram, error) {
+	wp := &WatchedProgram{
+		shaders:  shaders,
+		uniforms: make(map[string]UniformLocation),
+	}
+	program, stamps, complete, err := wp.build(w.FS)
+	wp.stamp(w.FS, stamps, complete)
+	if err != nil {
+		return nil, err
+	}
+	wp.Program = program
+	w.programs = append(w.programs, wp)
+	return wp, nil
+}
+
+// Poll checks the files of every watched program, if Interval has passed
+// since the last check, and rebuilds the programs whose files changed. A
+// program that fails to build is reported to OnError and the previous one
+// is kept. Call it once per frame.
+func (w *Watcher) Poll() {
+	now := time.Now()
+	if now.Sub(w.last) < w.Interval {
+		return
+	}
+	w.last = now
+
+	for _, wp := range w.programs {
+		if !wp.changed(w.FS) {
+			continue
+		}
+		program, stamps, complete, err := wp.build(w.FS)
+		wp.stamp(w.FS, stamps, complete)
+		if err != nil {
+			if w.OnError != nil {
+				w.OnError(err)
+			}
+			continue
+		}
+		wp.Program.Delete()
+		wp.Program = program
+		for name := range wp.uniforms {
+			wp.uniforms[name] = program.GetUniformLocation(name)
+		}
+		if wp.OnReload != nil {
+			wp.OnReload(program)
+		}
+	}
+}
+
+// Stops watching and deletes all programs
+func (w *Watcher) Delete() {
+	for _, wp := range w.programs {
+		wp.Program.Delete()
+		wp.Program = 0
+	}
+	w.programs = nil
+}
+
+// Returns the location of the uniform name in Program. Locations are cached
+// and looked up again after every reload.
+func (wp *WatchedProgram) Uniform(name string) UniformLocation {
+	location, ok := wp.uniforms[name]
+	if !ok {
+		location = wp.Program.GetUniformLocation(name)
+		wp.uniforms[name] = location
+	}
+	return location
+}
+
+// An fs.FS stamping every file before it is first read, so that a file
+// saved while building is seen as changed afterwards
+type stampingFS struct {
+	fs.FS
+	stamps map[string]fileStamp
+}
+
+func (fsys *stampingFS) Open(name string) (fs.File, error) {
+	if _, ok := fsys.stamps[name]; !ok {
+		fsys.stamps[name] = stampFile(fsys.FS, name)
+	}
+	return fsys.FS.Open(name)
+}
+
+// Compiles and links the shaders. The stamps of the files read, included
+// ones too, are returned even when building fails; complete reports
+// whether every shader was read.
+func (wp *WatchedProgram) build(fsys fs.FS) (program Program, stamps map[string]fileStamp, complete bool, err error) {
+	sfs := &stampingFS{fsys, make(map[string]fileStamp)}
+	sources := make([]*Source, len(wp.shaders))
+	for i, sf := range wp.shaders {
+		if sources[i], err = Preprocess(sfs, sf.Name); err != nil {
+			return 0, sfs.stamps, false, err
+		}
+	}
+
+	shaders := make([]Shader, 0, len(wp.shaders))
+	defer func() {
+		for _, shader := range shaders {
+			shader.Delete()
+		}
+	}()
+	for i, src := range sources {
+		shader, err := src.compile(wp.shaders[i].Type)
+		if err != nil {
+			return 0, sfs.stamps, true, err
+		}
+		shaders = append(shaders, shader)
+	}
+	program, err = NewProgram(shaders...)
+	return program, sfs.stamps, true, err
+}
+
+// Records stamps as the state of the watched files. When building stopped
+// before reading every shader, the files watched before are kept too, so
+// that the shaders not read are still watched.
+func (wp *WatchedProgram) stamp(fsys fs.FS, stamps map[string]fileStamp, complete bool) {
+	if !complete {
+		for name := range wp.files {
+			if _, ok := stamps[name]; !ok {
+				stamps[name] = stampFile(fsys, name)
+			}
+		}
+	}
+	wp.files = stamps
+}
+
+// Reports whether any file changed since the last stamp
+func (wp *WatchedProgram) changed(fsys fs.FS) bool {
+	for name, stamp := range wp.files {
+		if !stampFile(fsys, name).equal(stamp) {
+			return true
+		}
+	}
+	return false
+}
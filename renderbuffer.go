@@ -70,3 +70,36 @@ func (rb Renderbuffer) FramebufferRenderbuffer(target, attachment, renderbuffert
 	// TODO: sync stuff.  return (GLsync)(C.glFramebufferRenderbuffer (C.GLenum(target), C.GLenum(attachment), C.GLenum(renderbuffertarget), C.GLuint(rb)))
 	C.glFramebufferRenderbuffer(C.GLenum(target), C.GLenum(attachment), C.GLenum(renderbuffertarget), C.GLuint(rb))
 }
+
+// Direct State Access (GL 4.5). These do not change the bound renderbuffer.
+
+// void glCreateRenderbuffers(GLsizei n, GLuint *renderbuffers);
+func CreateRenderbuffer() Renderbuffer {
+	var b C.GLuint
+	C.glCreateRenderbuffers(1, &b)
+	return Renderbuffer(b)
+}
+
+func CreateRenderbuffers(bufs []Renderbuffer) {
+	if len(bufs) > 0 {
+		C.glCreateRenderbuffers(C.GLsizei(len(bufs)), (*C.GLuint)(&bufs[0]))
+	}
+}
+
+// void glNamedRenderbufferStorage(GLuint renderbuffer, GLenum internalformat, GLsizei width, GLsizei height);
+func (rb Renderbuffer) Storage(internalformat GLenum, width int, height int) {
+	C.glNamedRenderbufferStorage(C.GLuint(rb), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height))
+}
+
+// void glNamedRenderbufferStorageMultisample(GLuint renderbuffer, GLsizei samples, GLenum internalformat, GLsizei width, GLsizei height);
+func (rb Renderbuffer) StorageMultisample(samples int, internalformat GLenum, width, height int) {
+	C.glNamedRenderbufferStorageMultisample(C.GLuint(rb), C.GLsizei(samples), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height))
+}
+
+// void glGetNamedRenderbufferParameteriv(GLuint renderbuffer, GLenum pname, GLint *params);
+func (rb Renderbuffer) GetParameteriv(pname GLenum, params []int32) {
+	if len(params) == 0 {
+		panic("Invalid params size")
+	}
+	C.glGetNamedRenderbufferParameteriv(C.GLuint(rb), C.GLenum(pname), (*C.GLint)(&params[0]))
+}
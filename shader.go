@@ -12,6 +12,7 @@ package gl
 // }
 //
 import "C"
+import "unsafe"
 
 // Shader
 
@@ -53,14 +54,60 @@ func (shader Shader) GetSource() string {
 	return ""
 }
 
-func (shader Shader) Source(source string) {
+// void glShaderSource(GLuint shader, GLsizei count, const GLchar **string, const GLint *length);
+//
+// Sets the source of this shader to the concatenation of sources, which
+// compilers number as separate source strings.
+func (shader Shader) Source(sources ...string) {
+	if len(sources) == 0 {
+		panic("Invalid sources length")
+	}
+
+	csources := make([]*C.GLchar, len(sources))
+	lengths := make([]C.GLint, len(sources))
+	for i, source := range sources {
+		csources[i] = glString(source)
+		lengths[i] = C.GLint(len(source))
+	}
+	defer func() {
+		for _, s := range csources {
+			freeString(s)
+		}
+	}()
+
+	C.glShaderSource(C.GLuint(shader), C.GLsizei(len(csources)), &csources[0], &lengths[0])
+}
 
-	csource := glString(source)
-	defer freeString(csource)
+// void glShaderBinary(GLsizei count, const GLuint *shaders, GLenum binaryformat, const void *binary, GLsizei length);
+//
+// Loads a precompiled binary into this shader in place of source, such as a
+// SPIR-V module with format SHADER_BINARY_FORMAT_SPIR_V. SPIR-V shaders must
+// then be specialized instead of compiled.
+func (shader Shader) Binary(format GLenum, binary []byte) {
+	if len(binary) == 0 {
+		panic("Invalid binary length")
+	}
+	C.glShaderBinary(1, (*C.GLuint)(&shader), C.GLenum(format), unsafe.Pointer(&binary[0]), C.GLsizei(len(binary)))
+}
 
-	var one C.GLint = C.GLint(len(source))
+// void glSpecializeShader(GLuint shader, const GLchar *pEntryPoint, GLuint numSpecializationConstants, const GLuint *pConstantIndex, const GLuint *pConstantValue);
+//
+// Compiles a SPIR-V shader loaded with Binary, starting at function
+// entryPoint. Specialization constant constantIndex[i], the SpecId of the
+// module, is set to the bits of constantValue[i]; use math.Float32bits for
+// float constants. Check COMPILE_STATUS for errors.
+func (shader Shader) Specialize(entryPoint string, constantIndex, constantValue []uint32) {
+	if len(constantIndex) != len(constantValue) {
+		panic("constantIndex slice must be equal in length to constantValue slice.")
+	}
+	centry := glString(entryPoint)
+	defer freeString(centry)
 
-	C.glShaderSource(C.GLuint(shader), 1, &csource, &one)
+	var indices, values *C.GLuint
+	if len(constantIndex) > 0 {
+		indices, values = (*C.GLuint)(&constantIndex[0]), (*C.GLuint)(&constantValue[0])
+	}
+	C.glSpecializeShader(C.GLuint(shader), centry, C.GLuint(len(constantIndex)), indices, values)
 }
 
 func (shader Shader) Compile() { C.glCompileShader(C.GLuint(shader)) }
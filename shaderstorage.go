@@ -0,0 +1,110 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+// #include "gl.h"
+import "C"
+import (
+	"fmt"
+	"reflect"
+)
+
+// Shader Storage Blocks
+
+// Returns the index of the shader storage block, or INVALID_INDEX if the
+// program has no active block of that name.
+func (program Program) GetShaderStorageBlockIndex(name string) uint {
+	return program.GetProgramResourceIndex(SHADER_STORAGE_BLOCK, name)
+}
+
+// void glShaderStorageBlockBinding(GLuint program, GLuint storageBlockIndex, GLuint storageBlockBinding);
+//
+// Backs the block with the buffer bound to SHADER_STORAGE_BUFFER at index
+// blockBinding, see Buffer.BindShaderStorage.
+func (program Program) ShaderStorageBlockBinding(blockIndex, blockBinding uint) {
+	C.glShaderStorageBlockBinding(C.GLuint(program), C.GLuint(blockIndex), C.GLuint(blockBinding))
+}
+
+// Bind this buffer as index of SHADER_STORAGE_BUFFER
+func (buffer Buffer) BindShaderStorage(index uint) {
+	buffer.BindBufferBase(SHADER_STORAGE_BUFFER, index)
+}
+
+// Bind this buffer range as index of SHADER_STORAGE_BUFFER
+func (buffer Buffer) BindShaderStorageRange(index uint, offset int, size uint) {
+	buffer.BindBufferRange(SHADER_STORAGE_BUFFER, index, offset, size)
+}
+
+// Reads v, a pointer to a struct, an array or a slice laid out as std430,
+// from the buffer bound to target starting at offset. Slices are read with
+// the length they have, so size them to the number of elements wanted.
+func GetBufferSubDataStd430(target GLenum, offset int, v interface{}) error {
+	if rv := reflect.ValueOf(v); rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("gl: %T is not a pointer", v)
+	}
+	t, rv, err := std430.layoutOf(v)
+	if err != nil {
+		return err
+	}
+	b := make([]byte, t.sizeOf(rv))
+	if len(b) > 0 {
+		GetBufferSubData(target, offset, len(b), b)
+	}
+	t.decode(b, rv)
+	return nil
+}
+
+// CheckShaderStorageBlock compares the std430 layout of v, a struct as
+// produced by EncodeStd430, with the layout the linker reports for the
+// block. It returns an error naming the first member that is missing on
+// either side or whose type, array size, offset or strides differ.
+func (program Program) CheckShaderStorageBlock(blockIndex uint, v interface{}) error {
+	t, _, err := std430.layoutOf(v)
+	if err != nil {
+		return err
+	}
+	if t.kind != layoutStruct {
+		return fmt.Errorf("gl: %T is not a struct", v)
+	}
+	block := program.GetProgramResourceName(SHADER_STORAGE_BLOCK, blockIndex)
+	if block == "" {
+		return fmt.Errorf("gl: program %d has no shader storage block %d", program, blockIndex)
+	}
+
+	var params [2]int32
+	program.GetProgramResourceiv(SHADER_STORAGE_BLOCK, blockIndex,
+		[]GLenum{BUFFER_DATA_SIZE, NUM_ACTIVE_VARIABLES}, params[:])
+	size, n := int(params[0]), int(params[1])
+	// The GL counts one element of a trailing runtime-sized array
+	tsize := t.size
+	if k := len(t.fields); k > 0 && t.fields[k-1].typ.runtime {
+		tsize += t.fields[k-1].typ.stride
+	}
+	if size > tsize {
+		return fmt.Errorf("gl: shader storage block %s is %d bytes, %T only %d", block, size, v, tsize)
+	}
+	if n == 0 {
+		return nil
+	}
+
+	indices := make([]int32, n)
+	program.GetProgramResourceiv(SHADER_STORAGE_BLOCK, blockIndex, []GLenum{ACTIVE_VARIABLES}, indices)
+
+	props := []GLenum{TYPE, ARRAY_SIZE, OFFSET, ARRAY_STRIDE, MATRIX_STRIDE, TOP_LEVEL_ARRAY_STRIDE}
+	p := make([]int32, len(props))
+	return checkBlockMembers("shader storage block", block, v, t.bufferMembers(), n, func(i int) activeMember {
+		index := uint(indices[i])
+		program.GetProgramResourceiv(BUFFER_VARIABLE, index, props, p)
+		return activeMember{
+			name:         program.GetProgramResourceName(BUFFER_VARIABLE, index),
+			typ:          GLenum(p[0]),
+			size:         int(p[1]),
+			offset:       int(p[2]),
+			arrayStride:  int(p[3]),
+			matrixStride: int(p[4]),
+			topStride:    int(p[5]),
+		}
+	})
+}
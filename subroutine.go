@@ -0,0 +1,174 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+// #include "gl.h"
+import "C"
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Shader Subroutines
+
+// GLuint glGetSubroutineIndex(GLuint program, GLenum shadertype, const GLchar *name);
+//
+// Returns INVALID_INDEX if stage shadertype has no subroutine name.
+func (program Program) GetSubroutineIndex(shadertype GLenum, name string) uint {
+	cname := glString(name)
+	defer freeString(cname)
+	return uint(C.glGetSubroutineIndex(C.GLuint(program), C.GLenum(shadertype), cname))
+}
+
+// GLint glGetSubroutineUniformLocation(GLuint program, GLenum shadertype, const GLchar *name);
+//
+// Returns -1 if stage shadertype has no active subroutine uniform name.
+func (program Program) GetSubroutineUniformLocation(shadertype GLenum, name string) int {
+	cname := glString(name)
+	defer freeString(cname)
+	return int(C.glGetSubroutineUniformLocation(C.GLuint(program), C.GLenum(shadertype), cname))
+}
+
+// void glGetActiveSubroutineName(GLuint program, GLenum shadertype, GLuint index, GLsizei bufsize, GLsizei *length, GLchar *name);
+func (program Program) GetActiveSubroutineName(shadertype GLenum, index uint) string {
+	bufSize := program.GetProgramStageiv(shadertype, ACTIVE_SUBROUTINE_MAX_LENGTH)
+	if bufSize < 1 {
+		return ""
+	}
+	nameBuf := C.malloc(C.size_t(bufSize))
+	defer C.free(nameBuf)
+	C.glGetActiveSubroutineName(C.GLuint(program), C.GLenum(shadertype), C.GLuint(index), C.GLsizei(bufSize), nil, (*C.GLchar)(nameBuf))
+	return C.GoString((*C.char)(nameBuf))
+}
+
+// void glGetActiveSubroutineUniformName(GLuint program, GLenum shadertype, GLuint index, GLsizei bufsize, GLsizei *length, GLchar *name);
+func (program Program) GetActiveSubroutineUniformName(shadertype GLenum, index uint) string {
+	bufSize := program.GetProgramStageiv(shadertype, ACTIVE_SUBROUTINE_UNIFORM_MAX_LENGTH)
+	if bufSize < 1 {
+		return ""
+	}
+	nameBuf := C.malloc(C.size_t(bufSize))
+	defer C.free(nameBuf)
+	C.glGetActiveSubroutineUniformName(C.GLuint(program), C.GLenum(shadertype), C.GLuint(index), C.GLsizei(bufSize), nil, (*C.GLchar)(nameBuf))
+	return C.GoString((*C.char)(nameBuf))
+}
+
+// void glGetActiveSubroutineUniformiv(GLuint program, GLenum shadertype, GLuint index, GLenum pname, GLint *values);
+//
+// values must hold NUM_COMPATIBLE_SUBROUTINES values for
+// COMPATIBLE_SUBROUTINES, and one otherwise.
+func (program Program) GetActiveSubroutineUniformiv(shadertype GLenum, index uint, pname GLenum, values []int32) {
+	if len(values) == 0 {
+		panic("Invalid values length")
+	}
+	C.glGetActiveSubroutineUniformiv(C.GLuint(program), C.GLenum(shadertype), C.GLuint(index), C.GLenum(pname), (*C.GLint)(&values[0]))
+}
+
+// void glGetProgramStageiv(GLuint program, GLenum shadertype, GLenum pname, GLint *values);
+func (program Program) GetProgramStageiv(shadertype GLenum, pname GLenum) int {
+	var rv C.GLint
+	C.glGetProgramStageiv(C.GLuint(program), C.GLenum(shadertype), C.GLenum(pname), &rv)
+	return int(rv)
+}
+
+// void glUniformSubroutinesuiv(GLenum shadertype, GLsizei count, const GLuint *indices);
+//
+// Selects the subroutine of every subroutine uniform location of stage
+// shadertype of the current program; indices must hold
+// ACTIVE_SUBROUTINE_UNIFORM_LOCATIONS values. The selection is lost
+// whenever a program is used.
+func UniformSubroutinesuiv(shadertype GLenum, indices []uint32) {
+	if len(indices) == 0 {
+		panic("Invalid indices length")
+	}
+	C.glUniformSubroutinesuiv(C.GLenum(shadertype), C.GLsizei(len(indices)), (*C.GLuint)(&indices[0]))
+}
+
+// void glGetUniformSubroutineuiv(GLenum shadertype, GLint location, GLuint *params);
+//
+// Returns the index of the subroutine selected at location of stage
+// shadertype of the current program.
+func GetUniformSubroutineuiv(shadertype GLenum, location int) uint32 {
+	var rv C.GLuint
+	C.glGetUniformSubroutineuiv(C.GLenum(shadertype), C.GLint(location), &rv)
+	return uint32(rv)
+}
+
+// SelectSubroutines selects subroutines of stage shadertype by name, mapping
+// subroutine uniform names to the names of the subroutines they call.
+// Elements of subroutine uniform arrays are named as in "lights[1]". The
+// uniforms not in selection keep their current subroutine.
+//
+// program must be in use, and since using a program resets its selection,
+// SelectSubroutines must be called again after every Use. Nothing is
+// selected if a name is unknown or a subroutine does not match the type of
+// its uniform.
+func (program Program) SelectSubroutines(shadertype GLenum, selection map[string]string) error {
+	locations := program.GetProgramStageiv(shadertype, ACTIVE_SUBROUTINE_UNIFORM_LOCATIONS)
+	if locations == 0 {
+		if len(selection) == 0 {
+			return nil
+		}
+		return fmt.Errorf("gl: %s of program %d has no subroutine uniforms", stageName(shadertype), program)
+	}
+	indices := make([]uint32, locations)
+	for location := range indices {
+		indices[location] = GetUniformSubroutineuiv(shadertype, location)
+	}
+
+	// Sorted, so that the first problem reported does not vary
+	names := make([]string, 0, len(selection))
+	for name := range selection {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		location := program.GetSubroutineUniformLocation(shadertype, name)
+		if location < 0 {
+			return fmt.Errorf("gl: %s has no subroutine uniform %s", stageName(shadertype), name)
+		}
+		subroutine := selection[name]
+		index := program.GetSubroutineIndex(shadertype, subroutine)
+		if index == INVALID_INDEX {
+			return fmt.Errorf("gl: %s has no subroutine %s", stageName(shadertype), subroutine)
+		}
+		if !program.subroutineCompatible(shadertype, name, index) {
+			return fmt.Errorf("gl: subroutine %s cannot be selected for %s", subroutine, name)
+		}
+		indices[location] = uint32(index)
+	}
+	UniformSubroutinesuiv(shadertype, indices)
+	return nil
+}
+
+// Reports whether subroutine index matches the type of subroutine uniform
+// name, which may be an array element
+func (program Program) subroutineCompatible(shadertype GLenum, name string, index uint) bool {
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		name = name[:i]
+	}
+	uniforms := program.GetProgramStageiv(shadertype, ACTIVE_SUBROUTINE_UNIFORMS)
+	for u := 0; u < uniforms; u++ {
+		uname := program.GetActiveSubroutineUniformName(shadertype, uint(u))
+		if strings.TrimSuffix(uname, "[0]") != name {
+			continue
+		}
+		var n [1]int32
+		program.GetActiveSubroutineUniformiv(shadertype, uint(u), NUM_COMPATIBLE_SUBROUTINES, n[:])
+		if n[0] == 0 {
+			return false
+		}
+		compatible := make([]int32, n[0])
+		program.GetActiveSubroutineUniformiv(shadertype, uint(u), COMPATIBLE_SUBROUTINES, compatible)
+		for _, c := range compatible {
+			if uint(c) == index {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
@@ -509,3 +509,117 @@ func TexCoordPointer(size int, typ GLenum, stride int, pointer interface{}) {
 	C.glTexCoordPointer(C.GLint(size), C.GLenum(typ), C.GLsizei(stride),
 		ptr(pointer))
 }
+
+// Direct State Access (GL 4.5). These do not change any binding.
+
+//void glCreateTextures (GLenum target, GLsizei n, GLuint *textures)
+func CreateTexture(target GLenum) Texture {
+	var tex C.GLuint
+	C.glCreateTextures(C.GLenum(target), 1, &tex)
+	return Texture(tex)
+}
+
+// Fill slice with new textures of target
+func CreateTextures(target GLenum, textures []Texture) {
+	if len(textures) > 0 {
+		C.glCreateTextures(C.GLenum(target), C.GLsizei(len(textures)), (*C.GLuint)(&textures[0]))
+	}
+}
+
+//void glBindTextureUnit (GLuint unit, GLuint texture)
+//
+// Binds this texture to texture unit unit, which counts from 0 rather than
+// TEXTURE0, to the target it was created with.
+func (texture Texture) BindTextureUnit(unit uint) {
+	C.glBindTextureUnit(C.GLuint(unit), C.GLuint(texture))
+}
+
+//void glTextureStorage1D (GLuint texture, GLsizei levels, GLenum internalformat, GLsizei width)
+func (texture Texture) Storage1D(levels int, internalformat GLenum, width int) {
+	C.glTextureStorage1D(C.GLuint(texture), C.GLsizei(levels), C.GLenum(internalformat), C.GLsizei(width))
+}
+
+//void glTextureStorage2D (GLuint texture, GLsizei levels, GLenum internalformat, GLsizei width, GLsizei height)
+func (texture Texture) Storage2D(levels int, internalformat GLenum, width, height int) {
+	C.glTextureStorage2D(C.GLuint(texture), C.GLsizei(levels), C.GLenum(internalformat),
+		C.GLsizei(width), C.GLsizei(height))
+}
+
+//void glTextureStorage3D (GLuint texture, GLsizei levels, GLenum internalformat, GLsizei width, GLsizei height, GLsizei depth)
+func (texture Texture) Storage3D(levels int, internalformat GLenum, width, height, depth int) {
+	C.glTextureStorage3D(C.GLuint(texture), C.GLsizei(levels), C.GLenum(internalformat),
+		C.GLsizei(width), C.GLsizei(height), C.GLsizei(depth))
+}
+
+//void glTextureSubImage1D (GLuint texture, GLint level, GLint xoffset, GLsizei width, GLenum format, GLenum type, const void *pixels)
+func (texture Texture) SubImage1D(level int, xoffset int, width int, format, typ GLenum, pixels interface{}) {
+	C.glTextureSubImage1D(C.GLuint(texture), C.GLint(level), C.GLint(xoffset),
+		C.GLsizei(width), C.GLenum(format), C.GLenum(typ), ptr(pixels))
+}
+
+//void glTextureSubImage2D (GLuint texture, GLint level, GLint xoffset, GLint yoffset, GLsizei width, GLsizei height, GLenum format, GLenum type, const void *pixels)
+func (texture Texture) SubImage2D(level int, xoffset, yoffset int, width, height int, format, typ GLenum, pixels interface{}) {
+	C.glTextureSubImage2D(C.GLuint(texture), C.GLint(level), C.GLint(xoffset),
+		C.GLint(yoffset), C.GLsizei(width), C.GLsizei(height), C.GLenum(format),
+		C.GLenum(typ), ptr(pixels))
+}
+
+//void glTextureSubImage3D (GLuint texture, GLint level, GLint xoffset, GLint yoffset, GLint zoffset, GLsizei width, GLsizei height, GLsizei depth, GLenum format, GLenum type, const void *pixels)
+func (texture Texture) SubImage3D(level int, xoffset, yoffset, zoffset int, width, height, depth int, format, typ GLenum, pixels interface{}) {
+	C.glTextureSubImage3D(C.GLuint(texture), C.GLint(level), C.GLint(xoffset),
+		C.GLint(yoffset), C.GLint(zoffset), C.GLsizei(width), C.GLsizei(height),
+		C.GLsizei(depth), C.GLenum(format), C.GLenum(typ), ptr(pixels))
+}
+
+//void glTextureParameterf (GLuint texture, GLenum pname, GLfloat param)
+func (texture Texture) Parameterf(pname GLenum, param float32) {
+	C.glTextureParameterf(C.GLuint(texture), C.GLenum(pname), C.GLfloat(param))
+}
+
+//void glTextureParameterfv (GLuint texture, GLenum pname, const GLfloat *params)
+func (texture Texture) Parameterfv(pname GLenum, params []float32) {
+	if len(params) == 0 {
+		panic("Invalid params slice length")
+	}
+	C.glTextureParameterfv(C.GLuint(texture), C.GLenum(pname), (*C.GLfloat)(&params[0]))
+}
+
+//void glTextureParameteri (GLuint texture, GLenum pname, GLint param)
+func (texture Texture) Parameteri(pname GLenum, param int) {
+	C.glTextureParameteri(C.GLuint(texture), C.GLenum(pname), C.GLint(param))
+}
+
+//void glTextureParameteriv (GLuint texture, GLenum pname, const GLint *params)
+func (texture Texture) Parameteriv(pname GLenum, params []int32) {
+	if len(params) == 0 {
+		panic("Invalid params slice length")
+	}
+	C.glTextureParameteriv(C.GLuint(texture), C.GLenum(pname), (*C.GLint)(&params[0]))
+}
+
+//void glGetTextureParameteriv (GLuint texture, GLenum pname, GLint *params)
+func (texture Texture) GetParameteriv(pname GLenum, params []int32) {
+	if len(params) == 0 {
+		panic("Invalid params slice length")
+	}
+	C.glGetTextureParameteriv(C.GLuint(texture), C.GLenum(pname), (*C.GLint)(&params[0]))
+}
+
+//void glGetTextureLevelParameteriv (GLuint texture, GLint level, GLenum pname, GLint *params)
+func (texture Texture) GetLevelParameteriv(level int, pname GLenum, params []int32) {
+	if len(params) == 0 {
+		panic("Invalid params slice length")
+	}
+	C.glGetTextureLevelParameteriv(C.GLuint(texture), C.GLint(level), C.GLenum(pname), (*C.GLint)(&params[0]))
+}
+
+//void glGetTextureImage (GLuint texture, GLint level, GLenum format, GLenum type, GLsizei bufSize, void *pixels)
+func (texture Texture) GetImage(level int, format, typ GLenum, bufSize int, pixels interface{}) {
+	C.glGetTextureImage(C.GLuint(texture), C.GLint(level), C.GLenum(format),
+		C.GLenum(typ), C.GLsizei(bufSize), ptr(pixels))
+}
+
+//void glGenerateTextureMipmap (GLuint texture)
+func (texture Texture) GenerateMipmap() {
+	C.glGenerateTextureMipmap(C.GLuint(texture))
+}
@@ -35,6 +35,37 @@ func (feedback TransformFeedback) Draw(mode GLenum) {
 	C.glDrawTransformFeedback(C.GLenum(mode), C.GLuint(feedback))
 }
 
+// Draw what vertex stream 'stream' captured in the last Begin/End cycle from
+// this transform feedback using primitive type 'mode'
+func (feedback TransformFeedback) DrawStream(mode GLenum, stream uint) {
+	C.glDrawTransformFeedbackStream(C.GLenum(mode), C.GLuint(feedback), C.GLuint(stream))
+}
+
+// Draw 'instancecount' instances of the results of the last Begin/End cycle
+// from this transform feedback using primitive type 'mode'
+func (feedback TransformFeedback) DrawInstanced(mode GLenum, instancecount int) {
+	C.glDrawTransformFeedbackInstanced(C.GLenum(mode), C.GLuint(feedback), C.GLsizei(instancecount))
+}
+
+// Draw 'instancecount' instances of what vertex stream 'stream' captured in
+// the last Begin/End cycle from this transform feedback using primitive
+// type 'mode'
+func (feedback TransformFeedback) DrawStreamInstanced(mode GLenum, stream uint, instancecount int) {
+	C.glDrawTransformFeedbackStreamInstanced(C.GLenum(mode), C.GLuint(feedback), C.GLuint(stream), C.GLsizei(instancecount))
+}
+
+// Capture into 'buffer' at binding point 'index' of this transform feedback,
+// without binding either
+func (feedback TransformFeedback) BufferBase(index uint, buffer Buffer) {
+	C.glTransformFeedbackBufferBase(C.GLuint(feedback), C.GLuint(index), C.GLuint(buffer))
+}
+
+// Capture into 'size' bytes of 'buffer' from byte 'offset' at binding point
+// 'index' of this transform feedback, without binding either
+func (feedback TransformFeedback) BufferRange(index uint, buffer Buffer, offset int, size int) {
+	C.glTransformFeedbackBufferRange(C.GLuint(feedback), C.GLuint(index), C.GLuint(buffer), C.GLintptr(offset), C.GLsizeiptr(size))
+}
+
 // Delete all transform feedbacks in a slice
 func DeleteTransformFeedbacks(feedbacks []TransformFeedback) {
 	if len(feedbacks) > 0 {
@@ -57,6 +88,11 @@ func PauseTransformFeedback() {
 	C.glPauseTransformFeedback()
 }
 
+// Resume transform feedback after PauseTransformFeedback
+func ResumeTransformFeedback() {
+	C.glResumeTransformFeedback()
+}
+
 // End transform feedback
 func EndTransformFeedback() {
 	C.glEndTransformFeedback()
@@ -0,0 +1,146 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+// #include "gl.h"
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Uniform Blocks
+
+// GLuint glGetUniformBlockIndex(GLuint program, const GLchar *uniformBlockName);
+//
+// Returns INVALID_INDEX if the program has no active uniform block of that name.
+func (program Program) GetUniformBlockIndex(name string) uint {
+
+	cname := glString(name)
+	defer freeString(cname)
+
+	return uint(C.glGetUniformBlockIndex(C.GLuint(program), cname))
+}
+
+// void glUniformBlockBinding(GLuint program, GLuint uniformBlockIndex, GLuint uniformBlockBinding);
+//
+// Reads the block from the buffer bound to UNIFORM_BUFFER at index
+// blockBinding, see Buffer.BindBufferBase.
+func (program Program) UniformBlockBinding(blockIndex, blockBinding uint) {
+	C.glUniformBlockBinding(C.GLuint(program), C.GLuint(blockIndex), C.GLuint(blockBinding))
+}
+
+// void glGetActiveUniformBlockiv(GLuint program, GLuint uniformBlockIndex, GLenum pname, GLint *params);
+//
+// For single valued parameters, see GetActiveUniformBlockUniformIndices for
+// UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES.
+func (program Program) GetActiveUniformBlockiv(blockIndex uint, pname GLenum) int {
+	var rv C.GLint
+
+	C.glGetActiveUniformBlockiv(C.GLuint(program), C.GLuint(blockIndex), C.GLenum(pname), &rv)
+	return int(rv)
+}
+
+// Returns the indices of the active uniforms in the block.
+func (program Program) GetActiveUniformBlockUniformIndices(blockIndex uint) []uint32 {
+	n := program.GetActiveUniformBlockiv(blockIndex, UNIFORM_BLOCK_ACTIVE_UNIFORMS)
+	if n == 0 {
+		return nil
+	}
+	indices := make([]uint32, n)
+	C.glGetActiveUniformBlockiv(C.GLuint(program), C.GLuint(blockIndex),
+		C.GLenum(UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES), (*C.GLint)(unsafe.Pointer(&indices[0])))
+	return indices
+}
+
+// void glGetActiveUniformBlockName(GLuint program, GLuint uniformBlockIndex, GLsizei bufSize, GLsizei *length, GLchar *uniformBlockName);
+func (program Program) GetActiveUniformBlockName(blockIndex uint) string {
+	bufSize := program.GetActiveUniformBlockiv(blockIndex, UNIFORM_BLOCK_NAME_LENGTH)
+	if bufSize < 1 {
+		return ""
+	}
+	nameBuf := C.malloc(C.size_t(bufSize))
+	defer C.free(nameBuf)
+	C.glGetActiveUniformBlockName(C.GLuint(program), C.GLuint(blockIndex), C.GLsizei(bufSize), nil, (*C.GLchar)(nameBuf))
+	return C.GoString((*C.char)(nameBuf))
+}
+
+// void glGetUniformIndices(GLuint program, GLsizei uniformCount, const GLchar **uniformNames, GLuint *uniformIndices);
+//
+// Inactive uniforms get INVALID_INDEX.
+func (program Program) GetUniformIndices(names []string) []uint32 {
+	if len(names) == 0 {
+		return nil
+	}
+	gl_names := make([]*C.GLchar, len(names))
+	for i := range names {
+		gl_names[i] = glString(names[i])
+	}
+	indices := make([]uint32, len(names))
+	C.glGetUniformIndices(C.GLuint(program), C.GLsizei(len(gl_names)), &gl_names[0], (*C.GLuint)(&indices[0]))
+	for _, s := range gl_names {
+		freeString(s)
+	}
+	return indices
+}
+
+// void glGetActiveUniformsiv(GLuint program, GLsizei uniformCount, const GLuint *uniformIndices, GLenum pname, GLint *params);
+func (program Program) GetActiveUniformsiv(indices []uint32, pname GLenum) []int32 {
+	if len(indices) == 0 {
+		return nil
+	}
+	params := make([]int32, len(indices))
+	C.glGetActiveUniformsiv(C.GLuint(program), C.GLsizei(len(indices)), (*C.GLuint)(&indices[0]),
+		C.GLenum(pname), (*C.GLint)(&params[0]))
+	return params
+}
+
+// void glGetActiveUniformName(GLuint program, GLuint uniformIndex, GLsizei bufSize, GLsizei *length, GLchar *uniformName);
+func (program Program) GetActiveUniformName(index uint) string {
+	bufSize := program.Get(ACTIVE_UNIFORM_MAX_LENGTH)
+	if bufSize < 1 {
+		return ""
+	}
+	nameBuf := C.malloc(C.size_t(bufSize))
+	defer C.free(nameBuf)
+	C.glGetActiveUniformName(C.GLuint(program), C.GLuint(index), C.GLsizei(bufSize), nil, (*C.GLchar)(nameBuf))
+	return C.GoString((*C.char)(nameBuf))
+}
+
+// CheckUniformBlock compares the std140 layout of v, as produced by
+// EncodeStd140, with the layout the linker reports for the block. It returns
+// an error naming the first member that is missing on either side or whose
+// type, array size, offset or strides differ.
+func (program Program) CheckUniformBlock(blockIndex uint, v interface{}) error {
+	t, _, err := std140.layoutOf(v)
+	if err != nil {
+		return err
+	}
+	block := program.GetActiveUniformBlockName(blockIndex)
+	if block == "" {
+		return fmt.Errorf("gl: program %d has no uniform block %d", program, blockIndex)
+	}
+	if size := program.GetActiveUniformBlockiv(blockIndex, UNIFORM_BLOCK_DATA_SIZE); size > t.size {
+		return fmt.Errorf("gl: uniform block %s is %d bytes, %T only %d", block, size, v, t.size)
+	}
+
+	indices := program.GetActiveUniformBlockUniformIndices(blockIndex)
+	types := program.GetActiveUniformsiv(indices, UNIFORM_TYPE)
+	sizes := program.GetActiveUniformsiv(indices, UNIFORM_SIZE)
+	offsets := program.GetActiveUniformsiv(indices, UNIFORM_OFFSET)
+	arrayStrides := program.GetActiveUniformsiv(indices, UNIFORM_ARRAY_STRIDE)
+	matrixStrides := program.GetActiveUniformsiv(indices, UNIFORM_MATRIX_STRIDE)
+
+	return checkBlockMembers("uniform block", block, v, t.members("", 0, nil), len(indices), func(i int) activeMember {
+		return activeMember{
+			name:         program.GetActiveUniformName(uint(indices[i])),
+			typ:          GLenum(types[i]),
+			size:         int(sizes[i]),
+			offset:       int(offsets[i]),
+			arrayStride:  int(arrayStrides[i]),
+			matrixStride: int(matrixStrides[i]),
+		}
+	})
+}
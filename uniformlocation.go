@@ -226,3 +226,154 @@ func (location UniformLocation) UniformMatrix4x3f(transpose bool, matrix *[12]fl
 	}
 	C.glUniformMatrix4x3fv(C.GLint(location), 1, glBool(transpose), ((*C.GLfloat)((unsafe.Pointer)(&matrix[0]))))
 }
+
+func (location UniformLocation) Uniform1ui(x uint32) {
+	C.glUniform1ui(C.GLint(location), C.GLuint(x))
+}
+
+func (location UniformLocation) Uniform2ui(x uint32, y uint32) {
+	C.glUniform2ui(C.GLint(location), C.GLuint(x), C.GLuint(y))
+}
+
+func (location UniformLocation) Uniform3ui(x uint32, y uint32, z uint32) {
+	C.glUniform3ui(C.GLint(location), C.GLuint(x), C.GLuint(y), C.GLuint(z))
+}
+
+func (location UniformLocation) Uniform4ui(x uint32, y uint32, z uint32, w uint32) {
+	C.glUniform4ui(C.GLint(location), C.GLuint(x), C.GLuint(y), C.GLuint(z), C.GLuint(w))
+}
+
+func (location UniformLocation) Uniform1uiv(list ...uint32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniform1uiv(C.GLint(location), C.GLsizei(len(list)), (*C.GLuint)(&list[0]))
+}
+
+func (location UniformLocation) Uniform2uiv(list ...[2]uint32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniform2uiv(C.GLint(location), C.GLsizei(len(list)), (*C.GLuint)(&list[0][0]))
+}
+
+func (location UniformLocation) Uniform3uiv(list ...[3]uint32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniform3uiv(C.GLint(location), C.GLsizei(len(list)), (*C.GLuint)(&list[0][0]))
+}
+
+func (location UniformLocation) Uniform4uiv(list ...[4]uint32) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniform4uiv(C.GLint(location), C.GLsizei(len(list)), (*C.GLuint)(&list[0][0]))
+}
+
+func (location UniformLocation) Uniform1d(x float64) {
+	C.glUniform1d(C.GLint(location), C.GLdouble(x))
+}
+
+func (location UniformLocation) Uniform2d(x float64, y float64) {
+	C.glUniform2d(C.GLint(location), C.GLdouble(x), C.GLdouble(y))
+}
+
+func (location UniformLocation) Uniform3d(x float64, y float64, z float64) {
+	C.glUniform3d(C.GLint(location), C.GLdouble(x), C.GLdouble(y), C.GLdouble(z))
+}
+
+func (location UniformLocation) Uniform4d(x float64, y float64, z float64, w float64) {
+	C.glUniform4d(C.GLint(location), C.GLdouble(x), C.GLdouble(y), C.GLdouble(z), C.GLdouble(w))
+}
+
+func (location UniformLocation) Uniform1dv(list ...float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniform1dv(C.GLint(location), C.GLsizei(len(list)), (*C.GLdouble)(&list[0]))
+}
+
+func (location UniformLocation) Uniform2dv(list ...[2]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniform2dv(C.GLint(location), C.GLsizei(len(list)), (*C.GLdouble)(&list[0][0]))
+}
+
+func (location UniformLocation) Uniform3dv(list ...[3]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniform3dv(C.GLint(location), C.GLsizei(len(list)), (*C.GLdouble)(&list[0][0]))
+}
+
+func (location UniformLocation) Uniform4dv(list ...[4]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniform4dv(C.GLint(location), C.GLsizei(len(list)), (*C.GLdouble)(&list[0][0]))
+}
+
+func (location UniformLocation) UniformMatrix2dv(transpose bool, list ...[4]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniformMatrix2dv(C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (location UniformLocation) UniformMatrix3dv(transpose bool, list ...[9]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniformMatrix3dv(C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (location UniformLocation) UniformMatrix4dv(transpose bool, list ...[16]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniformMatrix4dv(C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (location UniformLocation) UniformMatrix2x3dv(transpose bool, list ...[6]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniformMatrix2x3dv(C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (location UniformLocation) UniformMatrix3x2dv(transpose bool, list ...[6]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniformMatrix3x2dv(C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (location UniformLocation) UniformMatrix2x4dv(transpose bool, list ...[8]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniformMatrix2x4dv(C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (location UniformLocation) UniformMatrix4x2dv(transpose bool, list ...[8]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniformMatrix4x2dv(C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (location UniformLocation) UniformMatrix3x4dv(transpose bool, list ...[12]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniformMatrix3x4dv(C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
+
+func (location UniformLocation) UniformMatrix4x3dv(transpose bool, list ...[12]float64) {
+	if len(list) < 1 {
+		panic("Invalid array length - must be at least 1")
+	}
+	C.glUniformMatrix4x3dv(C.GLint(location), C.GLsizei(len(list)), glBool(transpose), (*C.GLdouble)(&list[0][0]))
+}
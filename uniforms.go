@@ -0,0 +1,463 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+// #include "gl.h"
+import "C"
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// Uniform Structs
+
+// A field of a parameter struct and the uniform it sets
+type uniformField struct {
+	name     string
+	value    func(v reflect.Value) reflect.Value // the field of the struct
+	location UniformLocation
+	typ      GLenum
+	kind     reflect.Kind // of the components passed: Float32, Float64, Int32 or Uint32
+	size     int          // components per uniform array element
+}
+
+// How to set the uniforms of a program from a struct type
+type uniformPlan struct {
+	fields []uniformField
+	err    error // fields without a usable uniform
+}
+
+type uniformPlanKey struct {
+	program Program
+	typ     reflect.Type
+}
+
+// Plans by program and struct type. Programs drop theirs when deleted or
+// linked anew, since their uniform locations change.
+var uniformPlans = struct {
+	sync.Mutex
+	m map[uniformPlanKey]*uniformPlan
+}{m: make(map[uniformPlanKey]*uniformPlan)}
+
+func forgetUniformPlans(program Program) {
+	uniformPlans.Lock()
+	defer uniformPlans.Unlock()
+	for key := range uniformPlans.m {
+		if key.program == program {
+			delete(uniformPlans.m, key)
+		}
+	}
+}
+
+// Uniforms sets the uniforms of program from the fields of params, a
+// pointer to a struct. Fields are named by their gl tag, or by the field
+// name if there is none, and a tag of "-" skips the field. A tag may be a
+// complete uniform name, as in `gl:"lights[2].color"`, or an element of a
+// uniform array, as in `gl:"weights[2]"`, which sets the array from that
+// element on.
+//
+// The setter is picked from the type of the uniform, which the field must
+// match: float32 for float, [3]float32 for vec3, [16]float32 or
+// [4][4]float32 for mat4, int32 or int for int and samplers (the texture
+// unit), uint32 for uint, bool for bool, float64 for double types, and
+// arrays of these for uniform arrays. Nested structs and arrays of structs
+// set the members of GLSL structs.
+//
+// The program need not be in use. Locations are looked up once per program
+// and struct type, and looked up again after the program is linked anew.
+// The fields whose uniform is inactive, misspelled or of another type are
+// reported in the error; the others are set regardless.
+func (program Program) Uniforms(params interface{}) error {
+	v := reflect.ValueOf(params)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("gl: %T is not a pointer to a struct", params)
+	}
+	v = v.Elem()
+
+	key := uniformPlanKey{program, v.Type()}
+	uniformPlans.Lock()
+	plan, ok := uniformPlans.m[key]
+	if !ok {
+		plan = program.planUniforms(v.Type())
+		uniformPlans.m[key] = plan
+	}
+	uniformPlans.Unlock()
+
+	for _, f := range plan.fields {
+		f.set(program, f.value(v))
+	}
+	return plan.err
+}
+
+func (program Program) planUniforms(t reflect.Type) *uniformPlan {
+	plan := &uniformPlan{}
+	var problems []string
+	program.planStruct(t, "", func(v reflect.Value) reflect.Value { return v }, plan, &problems)
+	if len(problems) > 0 {
+		plan.err = fmt.Errorf("gl: %s: %s", t, strings.Join(problems, "; "))
+	}
+	return plan
+}
+
+func (program Program) planStruct(t reflect.Type, prefix string, get func(reflect.Value) reflect.Value,
+	plan *uniformPlan, problems *[]string) {
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, skip := parseGLTag(f)
+		if skip {
+			continue
+		}
+		name = prefix + name
+		index := i
+		fget := func(v reflect.Value) reflect.Value { return get(v).Field(index) }
+		program.planField(f.Type, name, fget, plan, problems)
+	}
+}
+
+func (program Program) planField(t reflect.Type, name string, get func(reflect.Value) reflect.Value,
+	plan *uniformPlan, problems *[]string) {
+
+	switch {
+	case t.Kind() == reflect.Struct:
+		program.planStruct(t, name+".", get, plan, problems)
+		return
+	case t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Struct:
+		for i := 0; i < t.Len(); i++ {
+			index := i
+			eget := func(v reflect.Value) reflect.Value { return get(v).Index(index) }
+			program.planStruct(t.Elem(), fmt.Sprintf("%s[%d].", name, i), eget, plan, problems)
+		}
+		return
+	}
+
+	kind, n := uniformComponents(t)
+	if kind == reflect.Invalid {
+		*problems = append(*problems, fmt.Sprintf("%s: unsupported field type %v", name, t))
+		return
+	}
+	// Array resources are named after their first element only, so other
+	// elements take their type from it
+	index := program.GetProgramResourceIndex(UNIFORM, name)
+	element := 0
+	if index == INVALID_INDEX {
+		if array, i, ok := splitArrayElement(name); ok {
+			index, element = program.GetProgramResourceIndex(UNIFORM, array+"[0]"), i
+		}
+	}
+	if index == INVALID_INDEX {
+		*problems = append(*problems, fmt.Sprintf("%s: no active uniform", name))
+		return
+	}
+	var values [3]int32
+	program.GetProgramResourceiv(UNIFORM, index, []GLenum{TYPE, ARRAY_SIZE, LOCATION}, values[:])
+	typ, arraySize, location := GLenum(values[0]), int(values[1]), int(values[2])
+	if location < 0 {
+		*problems = append(*problems, fmt.Sprintf("%s: uniform is in a block", name))
+		return
+	}
+	if element > 0 {
+		if element >= arraySize {
+			*problems = append(*problems, fmt.Sprintf("%s: no active uniform", name))
+			return
+		}
+		location = int(program.GetUniformLocation(name))
+		arraySize -= element
+	}
+
+	want, columns, rows := glTypeShape(typ)
+	size := columns * rows
+	switch {
+	case want == reflect.Invalid:
+		*problems = append(*problems, fmt.Sprintf("%s: cannot set %s uniforms", name, GLSLTypeName(typ)))
+		return
+	case !uniformKindFits(kind, want):
+		*problems = append(*problems, fmt.Sprintf("%s: %v cannot set %s", name, t, GLSLTypeName(typ)))
+		return
+	case n > 0 && (n%size != 0 || n/size > arraySize):
+		*problems = append(*problems, fmt.Sprintf("%s: %v does not fit %s[%d]", name, t, GLSLTypeName(typ), arraySize))
+		return
+	}
+	if want == reflect.Bool {
+		want = reflect.Int32
+	}
+	plan.fields = append(plan.fields, uniformField{name, get, UniformLocation(location), typ, want, size})
+}
+
+// Splits a name ending in an array subscript, as "weights[2]", into the
+// name of the array and the element.
+func splitArrayElement(name string) (array string, element int, ok bool) {
+	if !strings.HasSuffix(name, "]") {
+		return "", 0, false
+	}
+	open := strings.LastIndex(name, "[")
+	if open < 1 {
+		return "", 0, false
+	}
+	digits := name[open+1 : len(name)-1]
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return "", 0, false
+	}
+	element, err := strconv.Atoi(digits)
+	if err != nil {
+		return "", 0, false
+	}
+	return name[:open], element, true
+}
+
+// Returns the component kind of a field type and the number of components,
+// 0 for slices. Kind is Invalid for unsupported types.
+func uniformComponents(t reflect.Type) (reflect.Kind, int) {
+	n := 1
+	if t.Kind() == reflect.Slice {
+		n, t = 0, t.Elem()
+	}
+	for t.Kind() == reflect.Array {
+		n *= t.Len()
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64, reflect.Int32, reflect.Int, reflect.Uint32, reflect.Bool:
+		return t.Kind(), n
+	}
+	return reflect.Invalid, 0
+}
+
+func uniformKindFits(kind, want reflect.Kind) bool {
+	switch want {
+	case reflect.Int32:
+		return kind == reflect.Int32 || kind == reflect.Int
+	case reflect.Bool:
+		return kind == reflect.Bool || kind == reflect.Int32 || kind == reflect.Int
+	}
+	return kind == want
+}
+
+// Appends the components of v, converted to kind, to data
+func appendUniform(data []byte, v reflect.Value, kind reflect.Kind) []byte {
+	switch v.Kind() {
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			data = appendUniform(data, v.Index(i), kind)
+		}
+		return data
+	}
+	var b [8]byte
+	switch kind {
+	case reflect.Float32:
+		*(*float32)(unsafe.Pointer(&b[0])) = float32(v.Float())
+		return append(data, b[:4]...)
+	case reflect.Float64:
+		*(*float64)(unsafe.Pointer(&b[0])) = v.Float()
+		return append(data, b[:8]...)
+	case reflect.Uint32:
+		*(*uint32)(unsafe.Pointer(&b[0])) = uint32(v.Uint())
+		return append(data, b[:4]...)
+	}
+	var i int32
+	if v.Kind() == reflect.Bool {
+		if v.Bool() {
+			i = 1
+		}
+	} else {
+		i = int32(v.Int())
+	}
+	*(*int32)(unsafe.Pointer(&b[0])) = i
+	return append(data, b[:4]...)
+}
+
+func (f *uniformField) set(program Program, v reflect.Value) {
+	data := appendUniform(nil, v, f.kind)
+	count := len(data) / componentSize(f.kind) / f.size
+	if count == 0 {
+		return
+	}
+	p, l, n := C.GLuint(program), C.GLint(f.location), C.GLsizei(count)
+	fv, dv := (*C.GLfloat)(unsafe.Pointer(&data[0])), (*C.GLdouble)(unsafe.Pointer(&data[0]))
+	iv, uiv := (*C.GLint)(unsafe.Pointer(&data[0])), (*C.GLuint)(unsafe.Pointer(&data[0]))
+	switch f.typ {
+	case FLOAT:
+		C.glProgramUniform1fv(p, l, n, fv)
+	case FLOAT_VEC2:
+		C.glProgramUniform2fv(p, l, n, fv)
+	case FLOAT_VEC3:
+		C.glProgramUniform3fv(p, l, n, fv)
+	case FLOAT_VEC4:
+		C.glProgramUniform4fv(p, l, n, fv)
+	case DOUBLE:
+		C.glProgramUniform1dv(p, l, n, dv)
+	case DOUBLE_VEC2:
+		C.glProgramUniform2dv(p, l, n, dv)
+	case DOUBLE_VEC3:
+		C.glProgramUniform3dv(p, l, n, dv)
+	case DOUBLE_VEC4:
+		C.glProgramUniform4dv(p, l, n, dv)
+	case INT_VEC2, BOOL_VEC2:
+		C.glProgramUniform2iv(p, l, n, iv)
+	case INT_VEC3, BOOL_VEC3:
+		C.glProgramUniform3iv(p, l, n, iv)
+	case INT_VEC4, BOOL_VEC4:
+		C.glProgramUniform4iv(p, l, n, iv)
+	case UNSIGNED_INT:
+		C.glProgramUniform1uiv(p, l, n, uiv)
+	case UNSIGNED_INT_VEC2:
+		C.glProgramUniform2uiv(p, l, n, uiv)
+	case UNSIGNED_INT_VEC3:
+		C.glProgramUniform3uiv(p, l, n, uiv)
+	case UNSIGNED_INT_VEC4:
+		C.glProgramUniform4uiv(p, l, n, uiv)
+	case FLOAT_MAT2:
+		C.glProgramUniformMatrix2fv(p, l, n, C.GL_FALSE, fv)
+	case FLOAT_MAT3:
+		C.glProgramUniformMatrix3fv(p, l, n, C.GL_FALSE, fv)
+	case FLOAT_MAT4:
+		C.glProgramUniformMatrix4fv(p, l, n, C.GL_FALSE, fv)
+	case FLOAT_MAT2x3:
+		C.glProgramUniformMatrix2x3fv(p, l, n, C.GL_FALSE, fv)
+	case FLOAT_MAT2x4:
+		C.glProgramUniformMatrix2x4fv(p, l, n, C.GL_FALSE, fv)
+	case FLOAT_MAT3x2:
+		C.glProgramUniformMatrix3x2fv(p, l, n, C.GL_FALSE, fv)
+	case FLOAT_MAT3x4:
+		C.glProgramUniformMatrix3x4fv(p, l, n, C.GL_FALSE, fv)
+	case FLOAT_MAT4x2:
+		C.glProgramUniformMatrix4x2fv(p, l, n, C.GL_FALSE, fv)
+	case FLOAT_MAT4x3:
+		C.glProgramUniformMatrix4x3fv(p, l, n, C.GL_FALSE, fv)
+	case DOUBLE_MAT2:
+		C.glProgramUniformMatrix2dv(p, l, n, C.GL_FALSE, dv)
+	case DOUBLE_MAT3:
+		C.glProgramUniformMatrix3dv(p, l, n, C.GL_FALSE, dv)
+	case DOUBLE_MAT4:
+		C.glProgramUniformMatrix4dv(p, l, n, C.GL_FALSE, dv)
+	case DOUBLE_MAT2x3:
+		C.glProgramUniformMatrix2x3dv(p, l, n, C.GL_FALSE, dv)
+	case DOUBLE_MAT2x4:
+		C.glProgramUniformMatrix2x4dv(p, l, n, C.GL_FALSE, dv)
+	case DOUBLE_MAT3x2:
+		C.glProgramUniformMatrix3x2dv(p, l, n, C.GL_FALSE, dv)
+	case DOUBLE_MAT3x4:
+		C.glProgramUniformMatrix3x4dv(p, l, n, C.GL_FALSE, dv)
+	case DOUBLE_MAT4x2:
+		C.glProgramUniformMatrix4x2dv(p, l, n, C.GL_FALSE, dv)
+	case DOUBLE_MAT4x3:
+		C.glProgramUniformMatrix4x3dv(p, l, n, C.GL_FALSE, dv)
+	default:
+		// int, bool, samplers and images
+		C.glProgramUniform1iv(p, l, n, iv)
+	}
+}
+
+// Uniform Readback
+
+// Returns the type of the uniform at location and its array element there
+func (program Program) uniformAt(location UniformLocation) (name string, typ GLenum, ok bool) {
+	n := program.GetProgramInterfaceiv(UNIFORM, ACTIVE_RESOURCES)
+	var values [3]int32
+	for i := 0; i < n; i++ {
+		program.GetProgramResourceiv(UNIFORM, uint(i), []GLenum{TYPE, ARRAY_SIZE, LOCATION}, values[:])
+		base, size := UniformLocation(values[2]), UniformLocation(values[1])
+		if values[2] < 0 || location < base || location >= base+size {
+			continue
+		}
+		name = program.GetProgramResourceName(UNIFORM, uint(i))
+		if size > 1 || strings.HasSuffix(name, "[0]") {
+			name = fmt.Sprintf("%s[%d]", strings.TrimSuffix(name, "[0]"), location-base)
+		}
+		return name, GLenum(values[0]), true
+	}
+	return "", 0, false
+}
+
+// GetUniform returns the value of the uniform at location, shaped after its
+// type: float32, int32, uint32, float64 or bool for scalars and samplers,
+// arrays of these for vectors, such as [3]float32 for vec3, and flat arrays
+// in column-major order for matrices, such as [16]float32 for mat4. Only
+// the array element at location is returned for uniform arrays.
+func (program Program) GetUniform(location UniformLocation) (interface{}, error) {
+	name, typ, ok := program.uniformAt(location)
+	if !ok {
+		return nil, fmt.Errorf("gl: program %d has no active uniform at location %d", program, location)
+	}
+	value, ok := program.getUniform(location, typ)
+	if !ok {
+		return nil, fmt.Errorf("gl: cannot read %s uniform %s", GLSLTypeName(typ), name)
+	}
+	return value, nil
+}
+
+// Reads the uniform at location, of type typ. The slices read into are
+// sized after typ, so the GetnUniform functions are not needed.
+func (program Program) getUniform(location UniformLocation, typ GLenum) (interface{}, bool) {
+	kind, columns, rows := glTypeShape(typ)
+	size := columns * rows
+	var v reflect.Value
+	switch kind {
+	case reflect.Float32:
+		values := make([]float32, size)
+		program.GetUniformfv(location, values)
+		v = reflect.ValueOf(values)
+	case reflect.Float64:
+		values := make([]float64, size)
+		program.GetUniformdv(location, values)
+		v = reflect.ValueOf(values)
+	case reflect.Int32:
+		values := make([]int32, size)
+		program.GetUniformiv(location, values)
+		v = reflect.ValueOf(values)
+	case reflect.Uint32:
+		values := make([]uint32, size)
+		program.GetUniformuiv(location, values)
+		v = reflect.ValueOf(values)
+	case reflect.Bool:
+		values := make([]int32, size)
+		program.GetUniformiv(location, values)
+		bools := make([]bool, size)
+		for i, value := range values {
+			bools[i] = value != 0
+		}
+		v = reflect.ValueOf(bools)
+	default:
+		return nil, false
+	}
+	if size == 1 {
+		return v.Index(0).Interface(), true
+	}
+	array := reflect.New(reflect.ArrayOf(size, v.Type().Elem())).Elem()
+	reflect.Copy(array, v)
+	return array.Interface(), true
+}
+
+// DumpUniforms returns the current values of the active uniforms of the
+// default block, as returned by GetUniform, keyed by name. Every element of
+// a uniform array has its own entry, such as "lights[1].color". Uniforms
+// that cannot be read, atomic counters, are left out.
+func (program Program) DumpUniforms() map[string]interface{} {
+	n := program.GetProgramInterfaceiv(UNIFORM, ACTIVE_RESOURCES)
+	uniforms := make(map[string]interface{}, n)
+	var values [3]int32
+	for i := 0; i < n; i++ {
+		program.GetProgramResourceiv(UNIFORM, uint(i), []GLenum{TYPE, ARRAY_SIZE, LOCATION}, values[:])
+		typ, size, location := GLenum(values[0]), int(values[1]), UniformLocation(values[2])
+		if location < 0 {
+			continue
+		}
+		name := program.GetProgramResourceName(UNIFORM, uint(i))
+		for e := 0; e < size; e++ {
+			key := name
+			if size > 1 || strings.HasSuffix(name, "[0]") {
+				key = fmt.Sprintf("%s[%d]", strings.TrimSuffix(name, "[0]"), e)
+			}
+			if value, ok := program.getUniform(location+UniformLocation(e), typ); ok {
+				uniforms[key] = value
+			}
+		}
+	}
+	return uniforms
+}
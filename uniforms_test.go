@@ -0,0 +1,33 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import "testing"
+
+func TestSplitArrayElement(t *testing.T) {
+	tests := []struct {
+		name    string
+		array   string
+		element int
+		ok      bool
+	}{
+		{"weights[2]", "weights", 2, true},
+		{"weights[0]", "weights", 0, true},
+		{"lights[1].color[3]", "lights[1].color", 3, true},
+		{"lights[1].color", "", 0, false},
+		{"weights", "", 0, false},
+		{"weights[]", "", 0, false},
+		{"weights[-1]", "", 0, false},
+		{"weights[i]", "", 0, false},
+		{"[2]", "", 0, false},
+	}
+	for _, test := range tests {
+		array, element, ok := splitArrayElement(test.name)
+		if array != test.array || element != test.element || ok != test.ok {
+			t.Errorf("splitArrayElement(%q) = %q, %d, %v, want %q, %d, %v",
+				test.name, array, element, ok, test.array, test.element, test.ok)
+		}
+	}
+}
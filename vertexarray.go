@@ -35,3 +35,156 @@ func DeleteVertexArrays(arrays []VertexArray) {
 func (array VertexArray) Bind() {
 	C.glBindVertexArray(C.GLuint(array))
 }
+
+// Separate attribute formats and buffer bindings (ARB_vertex_attrib_binding).
+// These bind the array first, leaving it bound.
+
+// void glVertexAttribFormat(GLuint attribindex, GLint size, GLenum type, GLboolean normalized, GLuint relativeoffset);
+func (array VertexArray) VertexAttribFormat(attribindex AttribLocation, size int, typ GLenum, normalized bool, relativeoffset uint) {
+	array.Bind()
+	C.glVertexAttribFormat(C.GLuint(attribindex), C.GLint(size), C.GLenum(typ), glBool(normalized), C.GLuint(relativeoffset))
+}
+
+// void glVertexAttribIFormat(GLuint attribindex, GLint size, GLenum type, GLuint relativeoffset);
+func (array VertexArray) VertexAttribIFormat(attribindex AttribLocation, size int, typ GLenum, relativeoffset uint) {
+	array.Bind()
+	C.glVertexAttribIFormat(C.GLuint(attribindex), C.GLint(size), C.GLenum(typ), C.GLuint(relativeoffset))
+}
+
+// void glVertexAttribLFormat(GLuint attribindex, GLint size, GLenum type, GLuint relativeoffset);
+func (array VertexArray) VertexAttribLFormat(attribindex AttribLocation, size int, typ GLenum, relativeoffset uint) {
+	array.Bind()
+	C.glVertexAttribLFormat(C.GLuint(attribindex), C.GLint(size), C.GLenum(typ), C.GLuint(relativeoffset))
+}
+
+// void glVertexAttribBinding(GLuint attribindex, GLuint bindingindex);
+func (array VertexArray) VertexAttribBinding(attribindex AttribLocation, bindingindex uint) {
+	array.Bind()
+	C.glVertexAttribBinding(C.GLuint(attribindex), C.GLuint(bindingindex))
+}
+
+// void glBindVertexBuffer(GLuint bindingindex, GLuint buffer, GLintptr offset, GLsizei stride);
+func (array VertexArray) BindVertexBuffer(bindingindex uint, buffer Buffer, offset int, stride int) {
+	array.Bind()
+	C.glBindVertexBuffer(C.GLuint(bindingindex), C.GLuint(buffer), C.GLintptr(offset), C.GLsizei(stride))
+}
+
+// void glBindVertexBuffers(GLuint first, GLsizei count, const GLuint *buffers, const GLintptr *offsets, const GLsizei *strides);
+//
+// Binds buffers to consecutive binding points starting at first. offsets
+// and strides hold one entry per buffer.
+func (array VertexArray) BindVertexBuffers(first uint, buffers []Buffer, offsets []int, strides []int) {
+	count := len(buffers)
+	if len(offsets) != count || len(strides) != count {
+		panic("Invalid offsets or strides length")
+	}
+	array.Bind()
+	if count == 0 {
+		return
+	}
+	coffsets := make([]C.GLintptr, count)
+	cstrides := make([]C.GLsizei, count)
+	for i := range buffers {
+		coffsets[i] = C.GLintptr(offsets[i])
+		cstrides[i] = C.GLsizei(strides[i])
+	}
+	C.glBindVertexBuffers(C.GLuint(first), C.GLsizei(count), (*C.GLuint)(&buffers[0]), &coffsets[0], &cstrides[0])
+}
+
+// Unbinds count consecutive binding points starting at first.
+func (array VertexArray) UnbindVertexBuffers(first uint, count int) {
+	array.Bind()
+	C.glBindVertexBuffers(C.GLuint(first), C.GLsizei(count), nil, nil, nil)
+}
+
+// void glVertexBindingDivisor(GLuint bindingindex, GLuint divisor);
+func (array VertexArray) VertexBindingDivisor(bindingindex uint, divisor uint) {
+	array.Bind()
+	C.glVertexBindingDivisor(C.GLuint(bindingindex), C.GLuint(divisor))
+}
+
+// Direct State Access (GL 4.5). These do not change the bound array.
+
+// void glCreateVertexArrays(GLsizei n, GLuint *arrays);
+func CreateVertexArray() VertexArray {
+	var a C.GLuint
+	C.glCreateVertexArrays(1, &a)
+	return VertexArray(a)
+}
+
+func CreateVertexArrays(arrays []VertexArray) {
+	if len(arrays) > 0 {
+		C.glCreateVertexArrays(C.GLsizei(len(arrays)), (*C.GLuint)(&arrays[0]))
+	}
+}
+
+// void glEnableVertexArrayAttrib(GLuint vaobj, GLuint index);
+func (array VertexArray) EnableAttrib(index AttribLocation) {
+	C.glEnableVertexArrayAttrib(C.GLuint(array), C.GLuint(index))
+}
+
+// void glDisableVertexArrayAttrib(GLuint vaobj, GLuint index);
+func (array VertexArray) DisableAttrib(index AttribLocation) {
+	C.glDisableVertexArrayAttrib(C.GLuint(array), C.GLuint(index))
+}
+
+// void glVertexArrayAttribFormat(GLuint vaobj, GLuint attribindex, GLint size, GLenum type, GLboolean normalized, GLuint relativeoffset);
+func (array VertexArray) AttribFormat(attribindex AttribLocation, size int, typ GLenum, normalized bool, relativeoffset uint) {
+	C.glVertexArrayAttribFormat(C.GLuint(array), C.GLuint(attribindex), C.GLint(size), C.GLenum(typ), glBool(normalized), C.GLuint(relativeoffset))
+}
+
+// void glVertexArrayAttribIFormat(GLuint vaobj, GLuint attribindex, GLint size, GLenum type, GLuint relativeoffset);
+func (array VertexArray) AttribIFormat(attribindex AttribLocation, size int, typ GLenum, relativeoffset uint) {
+	C.glVertexArrayAttribIFormat(C.GLuint(array), C.GLuint(attribindex), C.GLint(size), C.GLenum(typ), C.GLuint(relativeoffset))
+}
+
+// void glVertexArrayAttribLFormat(GLuint vaobj, GLuint attribindex, GLint size, GLenum type, GLuint relativeoffset);
+func (array VertexArray) AttribLFormat(attribindex AttribLocation, size int, typ GLenum, relativeoffset uint) {
+	C.glVertexArrayAttribLFormat(C.GLuint(array), C.GLuint(attribindex), C.GLint(size), C.GLenum(typ), C.GLuint(relativeoffset))
+}
+
+// void glVertexArrayAttribBinding(GLuint vaobj, GLuint attribindex, GLuint bindingindex);
+func (array VertexArray) AttribBinding(attribindex AttribLocation, bindingindex uint) {
+	C.glVertexArrayAttribBinding(C.GLuint(array), C.GLuint(attribindex), C.GLuint(bindingindex))
+}
+
+// void glVertexArrayBindingDivisor(GLuint vaobj, GLuint bindingindex, GLuint divisor);
+func (array VertexArray) BindingDivisor(bindingindex uint, divisor uint) {
+	C.glVertexArrayBindingDivisor(C.GLuint(array), C.GLuint(bindingindex), C.GLuint(divisor))
+}
+
+// void glVertexArrayElementBuffer(GLuint vaobj, GLuint buffer);
+func (array VertexArray) ElementBuffer(buffer Buffer) {
+	C.glVertexArrayElementBuffer(C.GLuint(array), C.GLuint(buffer))
+}
+
+// void glVertexArrayVertexBuffer(GLuint vaobj, GLuint bindingindex, GLuint buffer, GLintptr offset, GLsizei stride);
+func (array VertexArray) VertexBuffer(bindingindex uint, buffer Buffer, offset int, stride int) {
+	C.glVertexArrayVertexBuffer(C.GLuint(array), C.GLuint(bindingindex), C.GLuint(buffer), C.GLintptr(offset), C.GLsizei(stride))
+}
+
+// void glVertexArrayVertexBuffers(GLuint vaobj, GLuint first, GLsizei count, const GLuint *buffers, const GLintptr *offsets, const GLsizei *strides);
+//
+// See BindVertexBuffers.
+func (array VertexArray) VertexBuffers(first uint, buffers []Buffer, offsets []int, strides []int) {
+	count := len(buffers)
+	if len(offsets) != count || len(strides) != count {
+		panic("Invalid offsets or strides length")
+	}
+	if count == 0 {
+		return
+	}
+	coffsets := make([]C.GLintptr, count)
+	cstrides := make([]C.GLsizei, count)
+	for i := range buffers {
+		coffsets[i] = C.GLintptr(offsets[i])
+		cstrides[i] = C.GLsizei(strides[i])
+	}
+	C.glVertexArrayVertexBuffers(C.GLuint(array), C.GLuint(first), C.GLsizei(count), (*C.GLuint)(&buffers[0]), &coffsets[0], &cstrides[0])
+}
+
+// Unbinds count consecutive binding points starting at first, without
+// binding array. See UnbindVertexBuffers.
+func (array VertexArray) UnsetVertexBuffers(first uint, count int) {
+	C.glVertexArrayVertexBuffers(C.GLuint(array), C.GLuint(first), C.GLsizei(count), nil, nil, nil)
+}
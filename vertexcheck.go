@@ -0,0 +1,248 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+)
+
+// Vertex Array Validation
+
+// A vertex shader input and the attribute locations it consumes
+type vertexInput struct {
+	name      string
+	typ       GLenum
+	location  AttribLocation
+	locations int // one per column of each array element
+}
+
+// Returns the active vertex shader inputs of program, skipping built-ins
+func vertexInputs(program Program) []vertexInput {
+	n := program.GetProgramInterfaceiv(PROGRAM_INPUT, ACTIVE_RESOURCES)
+	props := []GLenum{TYPE, ARRAY_SIZE, LOCATION}
+	values := make([]int32, len(props))
+	var inputs []vertexInput
+	for i := 0; i < n; i++ {
+		program.GetProgramResourceiv(PROGRAM_INPUT, uint(i), props, values)
+		if values[2] < 0 {
+			// gl_VertexID and friends
+			continue
+		}
+		input := vertexInput{
+			name:      program.GetProgramResourceName(PROGRAM_INPUT, uint(i)),
+			typ:       GLenum(values[0]),
+			location:  AttribLocation(values[2]),
+			locations: int(values[1]),
+		}
+		if _, columns, _ := glTypeShape(input.typ); columns > 0 {
+			input.locations *= columns
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs
+}
+
+func vertexAttribi(location AttribLocation, pname GLenum) int {
+	var v [1]int32
+	location.GetVertexAttribiv(pname, v[:])
+	return int(v[0])
+}
+
+// Binds array until the returned function restores the previous binding
+func bindVertexArrayForCheck(array VertexArray) (restore func()) {
+	var previous [1]int32
+	GetIntegerv(VERTEX_ARRAY_BINDING, previous[:])
+	array.Bind()
+	return func() { VertexArray(previous[0]).Bind() }
+}
+
+// Check reports the first problem that would make drawing array with
+// program read garbage: an attribute the program consumes that array does
+// not enable or backs with no buffer, or that is fed float data while the
+// input is an integer, or the other way around. array must not be 0. The
+// bound vertex array is restored before returning.
+//
+// See CheckDrawArrays and CheckDrawElements to also check buffer ranges.
+func Check(array VertexArray, program Program) error {
+	defer bindVertexArrayForCheck(array)()
+	_, err := checkVertexInputs(program)
+	return err
+}
+
+// Checks the inputs of program against the bound vertex array and returns
+// them
+func checkVertexInputs(program Program) ([]vertexInput, error) {
+	inputs := vertexInputs(program)
+	for _, input := range inputs {
+		for l := 0; l < input.locations; l++ {
+			if err := checkVertexInput(input, input.location+AttribLocation(l)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return inputs, nil
+}
+
+func checkVertexInput(input vertexInput, location AttribLocation) error {
+	if vertexAttribi(location, VERTEX_ATTRIB_ARRAY_ENABLED) == 0 {
+		return fmt.Errorf("gl: attribute %s (location %d) is not enabled", input.name, location)
+	}
+	if vertexAttribi(location, VERTEX_ATTRIB_ARRAY_BUFFER_BINDING) == 0 {
+		return fmt.Errorf("gl: attribute %s (location %d) has no buffer", input.name, location)
+	}
+
+	data := "float"
+	if vertexAttribi(location, VERTEX_ATTRIB_ARRAY_INTEGER) != 0 {
+		data = "integer"
+	} else if vertexAttribi(location, VERTEX_ATTRIB_ARRAY_LONG) != 0 {
+		data = "double"
+	}
+	kind, _, _ := glTypeShape(input.typ)
+	if kind == reflect.Invalid {
+		return nil
+	}
+	want := "float"
+	switch kind {
+	case reflect.Int32, reflect.Uint32:
+		want = "integer"
+	case reflect.Float64:
+		want = "double"
+	}
+	if data != want {
+		return fmt.Errorf("gl: %s attribute %s (location %d) is fed %s data", want, input.name, location, data)
+	}
+	return nil
+}
+
+// Returns the number of bytes one vertex of an attribute takes
+func vertexAttribBytes(size int, typ GLenum) int {
+	if size == BGRA {
+		size = 4
+	}
+	switch typ {
+	case INT_2_10_10_10_REV, UNSIGNED_INT_2_10_10_10_REV, UNSIGNED_INT_10F_11F_11F_REV:
+		return 4
+	case BYTE, UNSIGNED_BYTE:
+		return size
+	case SHORT, UNSIGNED_SHORT, HALF_FLOAT:
+		return 2 * size
+	case DOUBLE:
+		return 8 * size
+	}
+	return 4 * size
+}
+
+// Checks that the buffers of the bound vertex array hold vertex last for
+// every per-vertex attribute of inputs
+func checkVertexRange(inputs []vertexInput, last int) error {
+	for _, input := range inputs {
+		for l := 0; l < input.locations; l++ {
+			location := input.location + AttribLocation(l)
+			binding := uint(vertexAttribi(location, VERTEX_ATTRIB_BINDING))
+
+			var stride, divisor [1]int32
+			var offset [1]int64
+			GetIntegeri_v(VERTEX_BINDING_STRIDE, binding, stride[:])
+			GetIntegeri_v(VERTEX_BINDING_DIVISOR, binding, divisor[:])
+			GetInteger64i_v(VERTEX_BINDING_OFFSET, binding, offset[:])
+			if divisor[0] != 0 {
+				// Indexed by instance, not by vertex
+				continue
+			}
+
+			buffer := Buffer(vertexAttribi(location, VERTEX_ATTRIB_ARRAY_BUFFER_BINDING))
+			end := int(offset[0]) + vertexAttribi(location, VERTEX_ATTRIB_RELATIVE_OFFSET) +
+				last*int(stride[0]) + vertexAttribBytes(vertexAttribi(location, VERTEX_ATTRIB_ARRAY_SIZE),
+				GLenum(vertexAttribi(location, VERTEX_ATTRIB_ARRAY_TYPE)))
+			if size := int(buffer.GetParameteriv(BUFFER_SIZE)); end > size {
+				return fmt.Errorf("gl: attribute %s (location %d) reads up to byte %d of buffer %d for vertex %d, the buffer holds %d",
+					input.name, location, end, buffer, last, size)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckDrawArrays runs Check and then verifies that the buffers of array
+// hold the vertices DrawArrays(mode, first, count) reads. Attributes with a
+// divisor are not range checked.
+func CheckDrawArrays(array VertexArray, program Program, first, count int) error {
+	defer bindVertexArrayForCheck(array)()
+	inputs, err := checkVertexInputs(program)
+	if err != nil || count <= 0 {
+		return err
+	}
+	return checkVertexRange(inputs, first+count-1)
+}
+
+// CheckDrawElements runs Check and then verifies that array has an
+// ELEMENT_ARRAY_BUFFER holding count indices of type typ at byte offset
+// indices, and that its vertex buffers hold every vertex they refer to.
+// The indices are read back from the buffer to find the largest; the
+// primitive restart index is skipped when enabled.
+func CheckDrawElements(array VertexArray, program Program, count int, typ GLenum, indices int) error {
+	defer bindVertexArrayForCheck(array)()
+	inputs, err := checkVertexInputs(program)
+	if err != nil || count <= 0 {
+		return err
+	}
+
+	var element [1]int32
+	GetIntegerv(ELEMENT_ARRAY_BUFFER_BINDING, element[:])
+	if element[0] == 0 {
+		return fmt.Errorf("gl: vertex array %d has no ELEMENT_ARRAY_BUFFER", array)
+	}
+	buffer := Buffer(element[0])
+
+	var size int
+	var restart uint32
+	switch typ {
+	case UNSIGNED_BYTE:
+		size, restart = 1, 0xff
+	case UNSIGNED_SHORT:
+		size, restart = 2, 0xffff
+	case UNSIGNED_INT:
+		size, restart = 4, 0xffffffff
+	default:
+		return fmt.Errorf("gl: invalid index type 0x%x", typ)
+	}
+	if end, have := indices+count*size, int(buffer.GetParameteriv(BUFFER_SIZE)); end > have {
+		return fmt.Errorf("gl: %d indices at byte %d need %d bytes of ELEMENT_ARRAY_BUFFER %d, the buffer holds %d",
+			count, indices, end, buffer, have)
+	}
+	skip := IsEnabled(PRIMITIVE_RESTART_FIXED_INDEX)
+	if !skip && IsEnabled(PRIMITIVE_RESTART) {
+		var index [1]int32
+		GetIntegerv(PRIMITIVE_RESTART_INDEX, index[:])
+		skip, restart = true, uint32(index[0])
+	}
+
+	data := make([]byte, count*size)
+	buffer.GetSubData(indices, len(data), data)
+	last := -1
+	for i := 0; i < count; i++ {
+		var index uint32
+		switch size {
+		case 1:
+			index = uint32(data[i])
+		case 2:
+			index = uint32(binary.LittleEndian.Uint16(data[2*i:]))
+		case 4:
+			index = binary.LittleEndian.Uint32(data[4*i:])
+		}
+		if skip && index == restart {
+			continue
+		}
+		if int(index) > last {
+			last = int(index)
+		}
+	}
+	if last < 0 {
+		return nil
+	}
+	return checkVertexRange(inputs, last)
+}
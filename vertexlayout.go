@@ -0,0 +1,194 @@
+// Copyright 2012 The go-gl Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gl
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Vertex Layouts
+
+// An attribute of an interleaved vertex, one field of the vertex struct
+type VertexAttrib struct {
+	Name       string // name of the vertex shader input
+	Type       GLenum // component type, e.g. FLOAT or UNSIGNED_SHORT
+	Size       int    // components per column, 1 to 4
+	Columns    int    // 1 except for matrices, which use one location per column
+	Normalized bool   // map integer components to [0, 1] or [-1, 1]
+	Offset     int    // in bytes, from the start of the vertex
+
+	kind   reflect.Kind
+	column int // size of a column in bytes
+}
+
+// VertexLayout describes the attributes of interleaved vertex data as a Go
+// struct lays it out in memory.
+type VertexLayout struct {
+	Stride  int // size of the vertex struct
+	Attribs []VertexAttrib
+}
+
+var vertexComponentTypes = map[reflect.Kind]GLenum{
+	reflect.Int8:    BYTE,
+	reflect.Uint8:   UNSIGNED_BYTE,
+	reflect.Int16:   SHORT,
+	reflect.Uint16:  UNSIGNED_SHORT,
+	reflect.Int32:   INT,
+	reflect.Uint32:  UNSIGNED_INT,
+	reflect.Float32: FLOAT,
+	reflect.Float64: DOUBLE,
+}
+
+// NewVertexLayout derives the layout of v, a vertex struct or a pointer to
+// one. Exported fields are attributes named by their gl tag, or by the field
+// name if there is none; a tag of "-" skips the field. Fields are scalars,
+// [N]T vectors with N up to 4 or [C][R]T matrices with C and R from 2 to 4,
+// of 8, 16 or 32 bit integers, float32 or float64. The tag option
+// "normalized" maps integer components to [0, 1] or [-1, 1]:
+//
+//	type Vertex struct {
+//		Pos [3]float32 `gl:"position"`
+//		UV  [2]uint16  `gl:"uv,normalized"`
+//	}
+func NewVertexLayout(v interface{}) (*VertexLayout, error) {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("gl: %T is not a struct", v)
+	}
+
+	layout := &VertexLayout{Stride: int(t.Size())}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, opts, skip := parseGLTag(f)
+		if skip {
+			continue
+		}
+		attrib, err := vertexAttribOf(f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("gl: %v.%s: %v", t, f.Name, err)
+		}
+		attrib.Name = name
+		attrib.Offset = int(f.Offset)
+		if hasOption(opts, "normalized") {
+			if attrib.Type == FLOAT || attrib.Type == DOUBLE {
+				return nil, fmt.Errorf("gl: %v.%s: %v cannot be normalized", t, f.Name, f.Type)
+			}
+			attrib.Normalized = true
+		}
+		layout.Attribs = append(layout.Attribs, attrib)
+	}
+	return layout, nil
+}
+
+// Returns the component type and shape of a field type
+func vertexAttribOf(t reflect.Type) (VertexAttrib, error) {
+	attrib := VertexAttrib{Size: 1, Columns: 1}
+	if t.Kind() == reflect.Array {
+		if t.Len() < 1 || t.Len() > 4 {
+			return attrib, fmt.Errorf("unsupported vector size %d", t.Len())
+		}
+		attrib.Size = t.Len()
+		t = t.Elem()
+		if t.Kind() == reflect.Array {
+			if attrib.Size < 2 || t.Len() < 2 || t.Len() > 4 {
+				return attrib, fmt.Errorf("unsupported matrix size %dx%d", attrib.Size, t.Len())
+			}
+			attrib.Columns, attrib.Size = attrib.Size, t.Len()
+			attrib.column = int(t.Size())
+			t = t.Elem()
+			if t.Kind() != reflect.Float32 && t.Kind() != reflect.Float64 {
+				return attrib, fmt.Errorf("unsupported matrix component type %v", t)
+			}
+		}
+	}
+	typ, ok := vertexComponentTypes[t.Kind()]
+	if !ok {
+		return attrib, fmt.Errorf("unsupported component type %v", t)
+	}
+	attrib.Type = typ
+	attrib.kind = t.Kind()
+	return attrib, nil
+}
+
+// Checks that attrib can feed an input of type typ and returns the pointer
+// flavour to use: 'f' for AttribPointer, 'i' for AttribIPointer and 'l' for
+// AttribLPointer.
+func (attrib *VertexAttrib) check(typ GLenum) (byte, error) {
+	kind, columns, rows := glTypeShape(typ)
+	if kind == reflect.Invalid || kind == reflect.Bool {
+		return 0, fmt.Errorf("gl: attribute %s has unsupported type 0x%x", attrib.Name, typ)
+	}
+	if columns != attrib.Columns || attrib.Size > rows {
+		return 0, fmt.Errorf("gl: attribute %s has %dx%d components, the layout %dx%d",
+			attrib.Name, columns, rows, attrib.Columns, attrib.Size)
+	}
+	switch kind {
+	case reflect.Float32:
+		return 'f', nil
+	case reflect.Float64:
+		if attrib.kind != reflect.Float64 {
+			return 0, fmt.Errorf("gl: double attribute %s needs float64 components, not %v", attrib.Name, attrib.kind)
+		}
+		return 'l', nil
+	}
+	// Integer inputs take integer data as is
+	if attrib.Type == FLOAT || attrib.Type == DOUBLE || attrib.Normalized {
+		return 0, fmt.Errorf("gl: integer attribute %s needs unnormalized integer components", attrib.Name)
+	}
+	return 'i', nil
+}
+
+// Configures array to read the attributes of program from the vertices in
+// buffer, starting at offset. Every attribute of the layout must be an
+// active input of program with a compatible type; nothing is changed if one
+// is not. The previous vertex array and ARRAY_BUFFER bindings are restored.
+func (layout *VertexLayout) Setup(array VertexArray, program Program, buffer Buffer, offset int) error {
+	locations := make([]AttribLocation, len(layout.Attribs))
+	pointers := make([]byte, len(layout.Attribs))
+	for i := range layout.Attribs {
+		attrib := &layout.Attribs[i]
+		locations[i] = program.GetAttribLocation(attrib.Name)
+		index := program.GetProgramResourceIndex(PROGRAM_INPUT, attrib.Name)
+		if locations[i] < 0 || index == INVALID_INDEX {
+			return fmt.Errorf("gl: program %d has no active attribute %s", program, attrib.Name)
+		}
+		var typ [1]int32
+		program.GetProgramResourceiv(PROGRAM_INPUT, index, []GLenum{TYPE}, typ[:])
+		var err error
+		if pointers[i], err = attrib.check(GLenum(typ[0])); err != nil {
+			return err
+		}
+	}
+
+	var previousArray, previousBuffer [1]int32
+	GetIntegerv(VERTEX_ARRAY_BINDING, previousArray[:])
+	GetIntegerv(ARRAY_BUFFER_BINDING, previousBuffer[:])
+	defer Buffer(previousBuffer[0]).Bind(ARRAY_BUFFER)
+	defer VertexArray(previousArray[0]).Bind()
+
+	array.Bind()
+	buffer.Bind(ARRAY_BUFFER)
+	for i, attrib := range layout.Attribs {
+		// Matrices take one location per column
+		for c := 0; c < attrib.Columns; c++ {
+			location := locations[i] + AttribLocation(c)
+			pointer := uintptr(offset + attrib.Offset + c*attrib.column)
+			switch pointers[i] {
+			case 'f':
+				location.AttribPointer(uint(attrib.Size), attrib.Type, attrib.Normalized, layout.Stride, pointer)
+			case 'i':
+				location.AttribIPointer(uint(attrib.Size), attrib.Type, layout.Stride, pointer)
+			case 'l':
+				location.AttribLPointer(uint(attrib.Size), attrib.Type, layout.Stride, pointer)
+			}
+			location.EnableArray()
+		}
+	}
+	return nil
+}